@@ -158,6 +158,57 @@ func (t *Tree) Get(key []byte) []byte {
 	return v
 }
 
+// Depth returns the depth of the node with the given key, root of the tree is at depth 0.
+// Returns -1 if the key does not exist.
+func (t *Tree) Depth(key []byte) int {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	d := 0
+	n := t.root
+	for n != nil {
+		switch bytes.Compare(key, n.key) {
+		case 0:
+			return d
+		case 1:
+			n = n.right
+		case -1:
+			n = n.left
+		}
+		d++
+	}
+	return -1
+}
+
+// Shape returns the key value pairs of the tree in order and parent where parent[i] is the index of the parent of pairs[i],
+// -1 for the root. The tree is walked iteratively, so degenerate trees are handled without deep recursion.
+func (t *Tree) Shape() (pairs []*KVPair, parent []int) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	pairs = make([]*KVPair, 0, t.length)
+	index := make(map[*node]int, t.length)
+	stack := make([]*node, 0)
+	n := t.root
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, n)
+			n = n.left
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		index[n] = len(pairs)
+		pairs = append(pairs, &KVPair{Key: n.key, Value: n.value})
+		n = n.right
+	}
+	parent = make([]int, len(pairs))
+	for v, i := range index {
+		parent[i] = -1
+		if v.parent != nil {
+			parent[i] = index[v.parent]
+		}
+	}
+	return pairs, parent
+}
+
 // Traverse is inorder tree walk implementation
 func (t *Tree) Traverse() []*KVPair {
 	t.mtx.RLock()
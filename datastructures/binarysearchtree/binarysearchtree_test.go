@@ -2,6 +2,7 @@ package binarysearchtree
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -195,6 +196,49 @@ func TestTreeTraverse(t *testing.T) {
 	}
 }
 
+func TestTreeDepth(t *testing.T) {
+	tree := NewTree()
+	if tree.Depth([]byte("a")) != -1 {
+		t.Fatal("depth of a key in an empty tree should be -1")
+	}
+	// b is the root, a and d are its children, c is the left child of d
+	for _, k := range []string{"b", "a", "d", "c"} {
+		tree.Insert([]byte(k), []byte(k))
+	}
+	for k, want := range map[string]int{"a": 1, "b": 0, "c": 2, "d": 1, "e": -1} {
+		if got := tree.Depth([]byte(k)); got != want {
+			t.Fatalf("depth of %v is %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestTreeShape(t *testing.T) {
+	tree := NewTree()
+	if pairs, parent := tree.Shape(); len(pairs) != 0 || len(parent) != 0 {
+		t.Fatal("shape of an empty tree should be empty")
+	}
+	for _, k := range []string{"b", "a", "d", "c"} {
+		tree.Insert([]byte(k), []byte(k))
+	}
+	pairs, parent := tree.Shape()
+	want := []int{1, -1, 3, 1} // parents of a, b, c and d
+	for i, k := range []string{"a", "b", "c", "d"} {
+		if string(pairs[i].Key) != k || parent[i] != want[i] {
+			t.Fatalf("shape is %v, want parents %v", parent, want)
+		}
+	}
+	// a degenerate tree is walked without recursion
+	tree = NewTree()
+	cnt := 10000
+	for i := 0; i < cnt; i++ {
+		tree.Insert([]byte(fmt.Sprintf("%08d", i)), nil)
+	}
+	pairs, parent = tree.Shape()
+	if len(pairs) != cnt || parent[0] != -1 || parent[cnt-1] != cnt-2 {
+		t.Fatal("invalid shape of a degenerate tree")
+	}
+}
+
 const (
 	letterBytes string = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ!@#$%&*() "
 )
@@ -0,0 +1,140 @@
+package minpriorityqueue
+
+import (
+	"fmt"
+)
+
+// MinPriorityQueue is a min heap based priority queue of the items 0 to n-1, each item has an integer key.
+// The position of every item in the heap is kept, so the key of an item in the queue can be decreased in O(log n).
+type MinPriorityQueue struct {
+	heap     []int // items in heap order
+	keys     []int // keys[item] is the key of the item
+	position []int // position[item] is the index of the item in heap, -1 if the item is not in the queue
+}
+
+func (q *MinPriorityQueue) String() string {
+	r := make([]string, len(q.heap))
+	for i, item := range q.heap {
+		r[i] = fmt.Sprintf("%v:%v", item, q.keys[item])
+	}
+	return fmt.Sprint(r)
+}
+
+// basic operations are modified for 0 indexed heap
+func parent(n int) int {
+	return ((n + 1) >> 1) - 1
+}
+func left(n int) int {
+	return ((n + 1) << 1) - 1
+}
+func right(n int) int {
+	return left(n) + 1
+}
+
+// NewMinPriorityQueue returns a new empty minimum priority queue for the items 0 to n-1
+func NewMinPriorityQueue(n int) *MinPriorityQueue {
+	q := &MinPriorityQueue{
+		heap:     make([]int, 0, n),
+		keys:     make([]int, n),
+		position: make([]int, n),
+	}
+	for i := range q.position {
+		q.position[i] = -1
+	}
+	return q
+}
+
+// Len returns the number of items in the queue
+func (q *MinPriorityQueue) Len() int {
+	return len(q.heap)
+}
+
+// Contains returns true if the item is in the queue
+func (q *MinPriorityQueue) Contains(item int) bool {
+	return item >= 0 && item < len(q.position) && q.position[item] != -1
+}
+
+// Key returns the key of an item in the queue
+func (q *MinPriorityQueue) Key(item int) int {
+	if !q.Contains(item) {
+		panic("item is not in the queue")
+	}
+	return q.keys[item]
+}
+
+// Insert inserts an item with key k to the queue, the item must not be in the queue
+func (q *MinPriorityQueue) Insert(item, k int) {
+	if item < 0 || item >= len(q.position) {
+		panic("item out of range")
+	}
+	if q.position[item] != -1 {
+		panic("item is already in the queue")
+	}
+	q.heap = append(q.heap, item)
+	q.position[item] = len(q.heap) - 1
+	q.keys[item] = k
+	q.siftUp(len(q.heap) - 1)
+}
+
+// Minimum returns the item with the minimum key and its key, i.e. the first item in the queue
+func (q *MinPriorityQueue) Minimum() (item, k int) {
+	if len(q.heap) < 1 {
+		panic("heap underflow")
+	}
+	return q.heap[0], q.keys[q.heap[0]]
+}
+
+// ExtractMinimum extracts and returns the item with the minimum key and its key, and 'heapifies' the remaining items
+func (q *MinPriorityQueue) ExtractMinimum() (item, k int) {
+	item, k = q.Minimum()
+	last := len(q.heap) - 1
+	q.swap(0, last)
+	q.heap = q.heap[:last]
+	q.position[item] = -1
+	q.minHeapify(0)
+	return item, k
+}
+
+// DecreaseKey decreases the key of an item in the queue to k only if k is less than the key of the item.
+func (q *MinPriorityQueue) DecreaseKey(item, k int) {
+	if !q.Contains(item) {
+		panic("item is not in the queue")
+	}
+	if q.keys[item] <= k {
+		return
+	}
+	q.keys[item] = k
+	q.siftUp(q.position[item])
+}
+
+func (q *MinPriorityQueue) siftUp(i int) {
+	for i > 0 && q.keys[q.heap[parent(i)]] > q.keys[q.heap[i]] {
+		q.swap(i, parent(i))
+		i = parent(i)
+	}
+}
+
+func (q *MinPriorityQueue) minHeapify(i int) {
+	for {
+		l := left(i)
+		r := right(i)
+		smallest := i
+		if l < len(q.heap) && q.keys[q.heap[l]] < q.keys[q.heap[i]] {
+			smallest = l
+		}
+		if r < len(q.heap) && q.keys[q.heap[r]] < q.keys[q.heap[smallest]] {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (q *MinPriorityQueue) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.position[q.heap[i]] = i
+	q.position[q.heap[j]] = j
+}
@@ -0,0 +1,112 @@
+package minpriorityqueue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMinPriorityQueue(t *testing.T) {
+	tests := []struct {
+		name string
+		data []int
+	}{
+		{
+			"List_1",
+			[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			"List_2",
+			[]int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			"List_3",
+			[]int{9, 7, 5, 3, 1, 2, 4, 6, 8, 10},
+		},
+		{
+			"List_4",
+			[]int{1, 3, 5, 7, 9, 10, 8, 6, 4, 2},
+		},
+		{
+			"List_5",
+			[]int{10, 1, 9, 2, 8, 3, 7, 4, 6, 5},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// item i has key data[i] + 10, the keys are then decreased to data[i]
+			h := NewMinPriorityQueue(len(tc.data))
+			for i, k := range tc.data {
+				h.Insert(i, k+10)
+			}
+			t.Log(h)
+			for i, k := range tc.data {
+				h.DecreaseKey(i, k+20) // no-op
+				h.DecreaseKey(i, k)
+			}
+			t.Log(h)
+			for k := 1; k <= 10; k++ {
+				item, key := h.ExtractMinimum()
+				if key != k || tc.data[item] != k || h.Contains(item) {
+					t.Fatalf("ExtractMinimum() = %v, %v, want key %v", item, key, k)
+				}
+			}
+			if h.Len() != 0 {
+				t.Fatalf("Len() = %v, want 0", h.Len())
+			}
+		})
+	}
+}
+
+func TestMinPriorityQueueRandom(t *testing.T) {
+	for c := 0; c < 50; c++ {
+		n := 1 + rand.Intn(100)
+		h := NewMinPriorityQueue(n)
+		keys := make(map[int]int)
+		for _, item := range rand.Perm(n)[:1+rand.Intn(n)] {
+			keys[item] = rand.Intn(1000)
+			h.Insert(item, keys[item])
+		}
+		for item := range keys {
+			if rand.Intn(2) == 0 {
+				keys[item] -= rand.Intn(1000)
+				h.DecreaseKey(item, keys[item])
+			}
+			if h.Key(item) != keys[item] {
+				t.Fatalf("Key(%v) = %v, want %v", item, h.Key(item), keys[item])
+			}
+		}
+		want := make([]int, 0, len(keys))
+		for _, k := range keys {
+			want = append(want, k)
+		}
+		sort.Ints(want)
+		for _, k := range want {
+			if item, key := h.ExtractMinimum(); key != k || keys[item] != k {
+				t.Fatalf("ExtractMinimum() = %v, %v, want key %v", item, key, k)
+			}
+		}
+	}
+}
+
+func TestMinPriorityQueuePanics(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(h *MinPriorityQueue)
+	}{
+		{"underflow", func(h *MinPriorityQueue) { h.ExtractMinimum() }},
+		{"out of range", func(h *MinPriorityQueue) { h.Insert(3, 0) }},
+		{"insert twice", func(h *MinPriorityQueue) { h.Insert(1, 0); h.Insert(1, 0) }},
+		{"decrease missing", func(h *MinPriorityQueue) { h.DecreaseKey(1, 0) }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v did not panic", tc.name)
+				}
+			}()
+			tc.f(NewMinPriorityQueue(3))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package unionfind
+
+// UnionFind is a disjoint set forest of the elements 0 to n-1, i.e. union-find data structure.
+// Unions are by size and finds compress paths, so every operation takes nearly constant amortized time.
+// The structure is not thread-safe.
+type UnionFind struct {
+	parent []int // parent[x] is the parent of x in its tree, roots are their own parents
+	size   []int // size[x] is the number of elements in the tree of x if x is a root
+	count  int
+}
+
+// NewUnionFind returns a new union-find where every element of 0 to n-1 is in a set of its own
+func NewUnionFind(n int) *UnionFind {
+	u := &UnionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+	for x := range u.parent {
+		u.parent[x] = x
+		u.size[x] = 1
+	}
+	return u
+}
+
+// Find returns the representative of the set of x, i.e. the root of its tree
+func (u *UnionFind) Find(x int) int {
+	root := x
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+	// every element on the path now points to the root
+	for u.parent[x] != root {
+		u.parent[x], x = root, u.parent[x]
+	}
+	return root
+}
+
+// Union merges the sets of a and b, returns false if they are already in the same set
+func (u *UnionFind) Union(a, b int) bool {
+	a, b = u.Find(a), u.Find(b)
+	if a == b {
+		return false
+	}
+	// the smaller tree goes under the root of the larger tree
+	if u.size[a] < u.size[b] {
+		a, b = b, a
+	}
+	u.parent[b] = a
+	u.size[a] += u.size[b]
+	u.count--
+	return true
+}
+
+// Connected returns true if a and b are in the same set
+func (u *UnionFind) Connected(a, b int) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+// Size returns the number of elements in the set of x
+func (u *UnionFind) Size(x int) int {
+	return u.size[u.Find(x)]
+}
+
+// Count returns the number of disjoint sets
+func (u *UnionFind) Count() int {
+	return u.count
+}
@@ -0,0 +1,108 @@
+package unionfind
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	type union struct {
+		a, b int
+		want bool
+	}
+	tests := []struct {
+		name   string
+		n      int
+		unions []union
+		sets   [][]int
+	}{
+		{
+			name: "no unions",
+			n:    3,
+			sets: [][]int{{0}, {1}, {2}},
+		},
+		{
+			name:   "chain",
+			n:      5,
+			unions: []union{{0, 1, true}, {1, 2, true}, {2, 3, true}, {3, 0, false}},
+			sets:   [][]int{{0, 1, 2, 3}, {4}},
+		},
+		{
+			name:   "two sets",
+			n:      6,
+			unions: []union{{0, 2, true}, {4, 2, true}, {1, 3, true}, {5, 3, true}, {0, 4, false}, {1, 1, false}},
+			sets:   [][]int{{0, 2, 4}, {1, 3, 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewUnionFind(tt.n)
+			for _, un := range tt.unions {
+				if got := u.Union(un.a, un.b); got != un.want {
+					t.Fatalf("Union(%v, %v) = %v, want %v", un.a, un.b, got, un.want)
+				}
+			}
+			if u.Count() != len(tt.sets) {
+				t.Fatalf("Count() = %v, want %v", u.Count(), len(tt.sets))
+			}
+			for i, set := range tt.sets {
+				for _, x := range set {
+					if u.Size(x) != len(set) {
+						t.Errorf("Size(%v) = %v, want %v", x, u.Size(x), len(set))
+					}
+					for j, other := range tt.sets {
+						for _, y := range other {
+							if u.Connected(x, y) != (i == j) {
+								t.Errorf("Connected(%v, %v) = %v, want %v", x, y, !(i == j), i == j)
+							}
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUnionFindRandom(t *testing.T) {
+	n := 1000
+	u := NewUnionFind(n)
+	// label[x] is the set of x, relabelling a whole set on every union is the naive implementation
+	label := make([]int, n)
+	for x := range label {
+		label[x] = x
+	}
+	count := n
+	for i := 0; i < 2000; i++ {
+		a, b := rand.Intn(n), rand.Intn(n)
+		if got, want := u.Union(a, b), label[a] != label[b]; got != want {
+			t.Fatalf("Union(%v, %v) = %v, want %v", a, b, got, want)
+		}
+		if old := label[b]; old != label[a] {
+			for x := range label {
+				if label[x] == old {
+					label[x] = label[a]
+				}
+			}
+			count--
+		}
+		c, d := rand.Intn(n), rand.Intn(n)
+		if got := u.Connected(c, d); got != (label[c] == label[d]) {
+			t.Fatalf("Connected(%v, %v) = %v", c, d, got)
+		}
+	}
+	if u.Count() != count {
+		t.Errorf("Count() = %v, want %v", u.Count(), count)
+	}
+}
+
+func TestUnionFindLongChain(t *testing.T) {
+	// without union by size the tree would be a path
+	n := 100000
+	u := NewUnionFind(n)
+	for x := 1; x < n; x++ {
+		u.Union(x, x-1)
+	}
+	if u.Count() != 1 || u.Size(0) != n || !u.Connected(0, n-1) {
+		t.Errorf("Count() = %v, Size(0) = %v", u.Count(), u.Size(0))
+	}
+}
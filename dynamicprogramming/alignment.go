@@ -0,0 +1,242 @@
+package dynamicprogramming
+
+// Problem: align two sequences a and b by inserting gaps so that the alignment score is maximized.
+// Aligning two equal bytes scores Match, two different bytes scores Mismatch and aligning a byte with a gap scores Gap.
+// Needleman-Wunsch finds the best global alignment, i.e. both sequences are aligned from start to end.
+// Smith-Waterman finds the best local alignment, i.e. the best scoring pair of substrings of a and b.
+
+// AlignmentGap is the byte that is used to denote a gap in an aligned sequence
+const AlignmentGap byte = '-'
+
+// AlignmentScoring is the scoring scheme for sequence alignment.
+// Typically Match is positive while Mismatch and Gap are negative.
+type AlignmentScoring struct {
+	Match, Mismatch, Gap int
+}
+
+// DefaultAlignmentScoring is a commonly used scoring scheme
+var DefaultAlignmentScoring = AlignmentScoring{Match: 1, Mismatch: -1, Gap: -1}
+
+func (s AlignmentScoring) score(x, y byte) int {
+	if x == y {
+		return s.Match
+	}
+	return s.Mismatch
+}
+
+// SolveNeedlemanWunschRecursive returns the best global alignment score of a and b with a naive recursive approach.
+func SolveNeedlemanWunschRecursive(a, b []byte, s AlignmentScoring) int {
+	if len(a) == 0 {
+		return len(b) * s.Gap
+	}
+	if len(b) == 0 {
+		return len(a) * s.Gap
+	}
+	q := SolveNeedlemanWunschRecursive(a[:len(a)-1], b[:len(b)-1], s) + s.score(a[len(a)-1], b[len(b)-1])
+	if r := SolveNeedlemanWunschRecursive(a[:len(a)-1], b, s) + s.Gap; r > q {
+		q = r
+	}
+	if r := SolveNeedlemanWunschRecursive(a, b[:len(b)-1], s) + s.Gap; r > q {
+		q = r
+	}
+	return q
+}
+
+// SolveNeedlemanWunschMemoized returns the best global alignment score of a and b
+// by using a slice of slices for storing previously calculated values.
+// Scores can be negative, so known[i][j] marks whether m[i][j] is calculated.
+func SolveNeedlemanWunschMemoized(a, b []byte, s AlignmentScoring) int {
+	m := make([][]int, len(a)+1)
+	known := make([][]bool, len(a)+1)
+	for i := range m {
+		m[i] = make([]int, len(b)+1)
+		known[i] = make([]bool, len(b)+1)
+	}
+	return solveNeedlemanWunschMemoizedAux(a, b, s, len(a), len(b), m, known)
+}
+
+func solveNeedlemanWunschMemoizedAux(a, b []byte, s AlignmentScoring, i, j int, m [][]int, known [][]bool) int {
+	if i == 0 {
+		return j * s.Gap
+	}
+	if j == 0 {
+		return i * s.Gap
+	}
+	if known[i][j] {
+		return m[i][j]
+	}
+	q := solveNeedlemanWunschMemoizedAux(a, b, s, i-1, j-1, m, known) + s.score(a[i-1], b[j-1])
+	if r := solveNeedlemanWunschMemoizedAux(a, b, s, i-1, j, m, known) + s.Gap; r > q {
+		q = r
+	}
+	if r := solveNeedlemanWunschMemoizedAux(a, b, s, i, j-1, m, known) + s.Gap; r > q {
+		q = r
+	}
+	m[i][j] = q
+	known[i][j] = true
+	return q
+}
+
+// SolveNeedlemanWunschTabulated uses bottom up approach to find the best global alignment of a and b.
+// Returns the score and the aligned sequences, gaps are denoted by AlignmentGap.
+func SolveNeedlemanWunschTabulated(a, b []byte, s AlignmentScoring) (int, []byte, []byte) {
+	h := alignmentTable(a, b, s, false)
+	alignedA, alignedB, _, _ := alignmentTraceback(a, b, s, h, len(a), len(b), false)
+	return h[len(a)][len(b)], alignedA, alignedB
+}
+
+// SolveSmithWatermanRecursive returns the best local alignment score of a and b with a naive recursive approach.
+func SolveSmithWatermanRecursive(a, b []byte, s AlignmentScoring) int {
+	var q int
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if r := solveSmithWatermanRecursiveAux(a[:i], b[:j], s); r > q {
+				q = r
+			}
+		}
+	}
+	return q
+}
+
+// solveSmithWatermanRecursiveAux returns the best score of an alignment that ends at the last bytes of a and b
+func solveSmithWatermanRecursiveAux(a, b []byte, s AlignmentScoring) int {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	q := 0 // start a new alignment
+	if r := solveSmithWatermanRecursiveAux(a[:len(a)-1], b[:len(b)-1], s) + s.score(a[len(a)-1], b[len(b)-1]); r > q {
+		q = r
+	}
+	if r := solveSmithWatermanRecursiveAux(a[:len(a)-1], b, s) + s.Gap; r > q {
+		q = r
+	}
+	if r := solveSmithWatermanRecursiveAux(a, b[:len(b)-1], s) + s.Gap; r > q {
+		q = r
+	}
+	return q
+}
+
+// SolveSmithWatermanMemoized returns the best local alignment score of a and b
+// by using a slice of slices for storing previously calculated values.
+// Local alignment scores are never negative, so -1 means not calculated yet.
+func SolveSmithWatermanMemoized(a, b []byte, s AlignmentScoring) int {
+	m := make([][]int, len(a)+1)
+	for i := range m {
+		m[i] = make([]int, len(b)+1)
+		for j := range m[i] {
+			m[i][j] = -1
+		}
+	}
+	var q int
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if r := solveSmithWatermanMemoizedAux(a, b, s, i, j, m); r > q {
+				q = r
+			}
+		}
+	}
+	return q
+}
+
+func solveSmithWatermanMemoizedAux(a, b []byte, s AlignmentScoring, i, j int, m [][]int) int {
+	if i == 0 || j == 0 {
+		return 0
+	}
+	if m[i][j] >= 0 {
+		return m[i][j]
+	}
+	q := 0
+	if r := solveSmithWatermanMemoizedAux(a, b, s, i-1, j-1, m) + s.score(a[i-1], b[j-1]); r > q {
+		q = r
+	}
+	if r := solveSmithWatermanMemoizedAux(a, b, s, i-1, j, m) + s.Gap; r > q {
+		q = r
+	}
+	if r := solveSmithWatermanMemoizedAux(a, b, s, i, j-1, m) + s.Gap; r > q {
+		q = r
+	}
+	m[i][j] = q
+	return q
+}
+
+// SolveSmithWatermanTabulated uses bottom up approach to find the best local alignment of a and b.
+// Returns the score, the aligned substrings and the start indexes of the substrings in a and b.
+func SolveSmithWatermanTabulated(a, b []byte, s AlignmentScoring) (score int, alignedA, alignedB []byte, startA, startB int) {
+	h := alignmentTable(a, b, s, true)
+	bi, bj := 0, 0
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if h[i][j] > h[bi][bj] {
+				bi, bj = i, j
+			}
+		}
+	}
+	alignedA, alignedB, startA, startB = alignmentTraceback(a, b, s, h, bi, bj, true)
+	return h[bi][bj], alignedA, alignedB, startA, startB
+}
+
+// alignmentTable returns the table h where h[i][j] is the best score of an alignment of a[:i] and b[:j].
+// If local is true, alignments are allowed to start anywhere, i.e. scores are floored at 0.
+func alignmentTable(a, b []byte, s AlignmentScoring, local bool) [][]int {
+	h := make([][]int, len(a)+1)
+	for i := range h {
+		h[i] = make([]int, len(b)+1)
+		if !local {
+			h[i][0] = i * s.Gap
+		}
+	}
+	if !local {
+		for j := range h[0] {
+			h[0][j] = j * s.Gap
+		}
+	}
+	var q, r int
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			q = h[i-1][j-1] + s.score(a[i-1], b[j-1])
+			if r = h[i-1][j] + s.Gap; r > q {
+				q = r
+			}
+			if r = h[i][j-1] + s.Gap; r > q {
+				q = r
+			}
+			if local && q < 0 {
+				q = 0
+			}
+			h[i][j] = q
+		}
+	}
+	return h
+}
+
+// alignmentTraceback walks back from h[i][j] and returns the aligned sequences and where the alignment starts in a and b.
+// For local alignments, walking back stops at the first 0 score.
+func alignmentTraceback(a, b []byte, s AlignmentScoring, h [][]int, i, j int, local bool) ([]byte, []byte, int, int) {
+	alignedA := make([]byte, 0, i+j)
+	alignedB := make([]byte, 0, i+j)
+	for i > 0 || j > 0 {
+		if local && h[i][j] == 0 {
+			break
+		}
+		switch {
+		case i > 0 && j > 0 && h[i][j] == h[i-1][j-1]+s.score(a[i-1], b[j-1]):
+			alignedA = append(alignedA, a[i-1])
+			alignedB = append(alignedB, b[j-1])
+			i--
+			j--
+		case i > 0 && h[i][j] == h[i-1][j]+s.Gap:
+			alignedA = append(alignedA, a[i-1])
+			alignedB = append(alignedB, AlignmentGap)
+			i--
+		default:
+			alignedA = append(alignedA, AlignmentGap)
+			alignedB = append(alignedB, b[j-1])
+			j--
+		}
+	}
+	for k, l := 0, len(alignedA)-1; k < l; k, l = k+1, l-1 {
+		alignedA[k], alignedA[l] = alignedA[l], alignedA[k]
+		alignedB[k], alignedB[l] = alignedB[l], alignedB[k]
+	}
+	return alignedA, alignedB, i, j
+}
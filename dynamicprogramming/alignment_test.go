@@ -0,0 +1,139 @@
+package dynamicprogramming
+
+import (
+	"bytes"
+	"testing"
+)
+
+// alignmentScore returns the score of two aligned sequences
+func alignmentScore(alignedA, alignedB []byte, s AlignmentScoring) int {
+	var q int
+	for i := range alignedA {
+		if alignedA[i] == AlignmentGap || alignedB[i] == AlignmentGap {
+			q += s.Gap
+		} else {
+			q += s.score(alignedA[i], alignedB[i])
+		}
+	}
+	return q
+}
+
+// removeGaps returns the aligned sequence without the gaps
+func removeGaps(aligned []byte) []byte {
+	return bytes.Replace(aligned, []byte{AlignmentGap}, nil, -1)
+}
+
+func TestNeedlemanWunsch(t *testing.T) {
+	type args struct {
+		a, b []byte
+		s    AlignmentScoring
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "wikipedia",
+			args: args{[]byte("GCATGCG"), []byte("GATTACA"), DefaultAlignmentScoring},
+			want: 0,
+		},
+		{
+			name: "equal",
+			args: args{[]byte("GATTACA"), []byte("GATTACA"), DefaultAlignmentScoring},
+			want: 7,
+		},
+		{
+			name: "empty",
+			args: args{[]byte(""), []byte("GATTACA"), DefaultAlignmentScoring},
+			want: -7,
+		},
+		{
+			name: "expensive gaps",
+			args: args{[]byte("AAAA"), []byte("TAAAAT"), AlignmentScoring{Match: 2, Mismatch: -1, Gap: -5}},
+			want: -10 + 8,
+		},
+		{
+			name: "expensive mismatches",
+			args: args{[]byte("ACGT"), []byte("TGCA"), AlignmentScoring{Match: 1, Mismatch: -10, Gap: -1}},
+			want: -5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveNeedlemanWunschRecursive(tt.args.a, tt.args.b, tt.args.s); got != tt.want {
+				t.Errorf("SolveNeedlemanWunschRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveNeedlemanWunschMemoized(tt.args.a, tt.args.b, tt.args.s); got != tt.want {
+				t.Errorf("SolveNeedlemanWunschMemoized() = %v, want %v", got, tt.want)
+			}
+			got, alignedA, alignedB := SolveNeedlemanWunschTabulated(tt.args.a, tt.args.b, tt.args.s)
+			if got != tt.want {
+				t.Errorf("SolveNeedlemanWunschTabulated() = %v, want %v", got, tt.want)
+			}
+			if len(alignedA) != len(alignedB) {
+				t.Fatalf("SolveNeedlemanWunschTabulated() aligned lengths differ %q %q", alignedA, alignedB)
+			}
+			if q := alignmentScore(alignedA, alignedB, tt.args.s); q != tt.want {
+				t.Errorf("SolveNeedlemanWunschTabulated() alignment %q %q scores %v, want %v", alignedA, alignedB, q, tt.want)
+			}
+			if !bytes.Equal(removeGaps(alignedA), tt.args.a) || !bytes.Equal(removeGaps(alignedB), tt.args.b) {
+				t.Errorf("SolveNeedlemanWunschTabulated() alignment %q %q does not match the input", alignedA, alignedB)
+			}
+		})
+	}
+}
+
+func TestSmithWaterman(t *testing.T) {
+	type args struct {
+		a, b []byte
+		s    AlignmentScoring
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "wikipedia",
+			args: args{[]byte("TGTTACGG"), []byte("GGTTGACTA"), AlignmentScoring{Match: 3, Mismatch: -3, Gap: -2}},
+			want: 13,
+		},
+		{
+			name: "common substring",
+			args: args{[]byte("XXXABCDYYY"), []byte("ZZABCDZZ"), DefaultAlignmentScoring},
+			want: 4,
+		},
+		{
+			name: "nothing in common",
+			args: args{[]byte("AAA"), []byte("TTT"), DefaultAlignmentScoring},
+			want: 0,
+		},
+		{
+			name: "empty",
+			args: args{[]byte(""), []byte("TTT"), DefaultAlignmentScoring},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveSmithWatermanRecursive(tt.args.a, tt.args.b, tt.args.s); got != tt.want {
+				t.Errorf("SolveSmithWatermanRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveSmithWatermanMemoized(tt.args.a, tt.args.b, tt.args.s); got != tt.want {
+				t.Errorf("SolveSmithWatermanMemoized() = %v, want %v", got, tt.want)
+			}
+			got, alignedA, alignedB, startA, startB := SolveSmithWatermanTabulated(tt.args.a, tt.args.b, tt.args.s)
+			if got != tt.want {
+				t.Errorf("SolveSmithWatermanTabulated() = %v, want %v", got, tt.want)
+			}
+			if q := alignmentScore(alignedA, alignedB, tt.args.s); q != tt.want {
+				t.Errorf("SolveSmithWatermanTabulated() alignment %q %q scores %v, want %v", alignedA, alignedB, q, tt.want)
+			}
+			subA, subB := removeGaps(alignedA), removeGaps(alignedB)
+			if !bytes.Equal(tt.args.a[startA:startA+len(subA)], subA) || !bytes.Equal(tt.args.b[startB:startB+len(subB)], subB) {
+				t.Errorf("SolveSmithWatermanTabulated() alignment %q %q does not match the input at %v, %v", alignedA, alignedB, startA, startB)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package dynamicprogramming
+
+import "math/big"
+
+// Overflow-safe arithmetic.
+// Costs and values of dynamic programming tables grow quickly, e.g. matrix chain costs are sums of products of three dimensions.
+// Solvers either stop with ErrOverflow, saturate at the limits of int or fall back to math/big.
+
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// OverflowMode selects what a solver does when a value does not fit into an int.
+type OverflowMode int
+
+const (
+	// OverflowError stops the solver with ErrOverflow.
+	OverflowError OverflowMode = iota
+	// OverflowSaturate clamps values to the largest or smallest int, so overflowed values still compare as very large or very small.
+	OverflowSaturate
+	// OverflowWrap wraps around silently like plain int arithmetic.
+	OverflowWrap
+)
+
+// AddChecked returns a + b, or ErrOverflow if the sum does not fit into an int.
+func AddChecked(a, b int) (int, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// MulChecked returns a * b, or ErrOverflow if the product does not fit into an int.
+func MulChecked(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == minInt) || (b == -1 && a == minInt) {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// AddSaturating returns a + b clamped to the range of int.
+func AddSaturating(a, b int) int {
+	c, err := AddChecked(a, b)
+	if err == nil {
+		return c
+	}
+	if b > 0 {
+		return maxInt
+	}
+	return minInt
+}
+
+// MulSaturating returns a * b clamped to the range of int.
+func MulSaturating(a, b int) int {
+	c, err := MulChecked(a, b)
+	if err == nil {
+		return c
+	}
+	if (a < 0) != (b < 0) {
+		return minInt
+	}
+	return maxInt
+}
+
+// arithmetic does int arithmetic in a given mode and remembers whether anything overflowed.
+type arithmetic struct {
+	mode     OverflowMode
+	overflow bool
+}
+
+func (a *arithmetic) add(x, y int) int {
+	if a.mode == OverflowWrap {
+		return x + y
+	}
+	c, err := AddChecked(x, y)
+	if err != nil {
+		a.overflow = true
+		return AddSaturating(x, y)
+	}
+	return c
+}
+
+func (a *arithmetic) mul(x, y int) int {
+	if a.mode == OverflowWrap {
+		return x * y
+	}
+	c, err := MulChecked(x, y)
+	if err != nil {
+		a.overflow = true
+		return MulSaturating(x, y)
+	}
+	return c
+}
+
+// result returns ErrOverflow if v is the result of an overflow and the mode is OverflowError.
+// Overflowed values saturate, so with non-negative values a result is exact unless it is saturated itself,
+// i.e. an overflow in a sub-problem that is not part of the best solution does not make the result overflow.
+func (a *arithmetic) result(v int) (int, error) {
+	if a.mode == OverflowError && a.overflow && (v == maxInt || v == minInt) {
+		return 0, ErrOverflow
+	}
+	return v, nil
+}
+
+// mustResult is result for solvers without an error return, it panics with ErrOverflow instead.
+func (a *arithmetic) mustResult(v int) int {
+	r, err := a.result(v)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// bigIntervalSolution holds the tables of an interval problem that is solved with math/big, see IntervalSolution.
+type bigIntervalSolution struct {
+	Value [][]*big.Int
+	Split [][]int
+}
+
+// solveBigIntervalProblem solves an interval problem like SolveIntervalProblem does, with values of arbitrary size.
+func solveBigIntervalProblem(n int, base func(i int) *big.Int, combine func(i, k, j int, left, right *big.Int) *big.Int, maximize bool) *bigIntervalSolution {
+	s := &bigIntervalSolution{Value: make([][]*big.Int, n+1)}
+	for i := range s.Value {
+		s.Value[i] = make([]*big.Int, n+1)
+	}
+	s.Split = solveIntervals(n, false, func(i int) {
+		s.Value[i][i+1] = base(i)
+	}, func(i, k, j int, first bool) bool {
+		v := combine(i, k, j, s.Value[i][k], s.Value[k][j])
+		if first || (maximize && v.Cmp(s.Value[i][j]) > 0) || (!maximize && v.Cmp(s.Value[i][j]) < 0) {
+			s.Value[i][j] = v
+			return true
+		}
+		return false
+	})
+	return s
+}
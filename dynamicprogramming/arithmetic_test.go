@@ -0,0 +1,83 @@
+package dynamicprogramming
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArithmetic(t *testing.T) {
+	tests := []struct {
+		name        string
+		a, b        int
+		add, mul    int
+		addOverflow bool
+		mulOverflow bool
+	}{
+		{
+			name: "small",
+			a:    6, b: -7,
+			add: -1, mul: -42,
+		},
+		{
+			name: "zero",
+			a:    math.MaxInt64, b: 0,
+			add: math.MaxInt64, mul: 0,
+		},
+		{
+			name: "max plus one",
+			a:    math.MaxInt64, b: 1,
+			add: math.MaxInt64, mul: math.MaxInt64,
+			addOverflow: true,
+		},
+		{
+			name: "min minus one",
+			a:    math.MinInt64, b: -1,
+			add: math.MinInt64, mul: math.MaxInt64,
+			addOverflow: true, mulOverflow: true,
+		},
+		{
+			name: "min times minus one",
+			a:    -1, b: math.MinInt64,
+			add: math.MinInt64, mul: math.MaxInt64,
+			addOverflow: true, mulOverflow: true,
+		},
+		{
+			name: "half max times two",
+			a:    math.MaxInt64 / 2, b: 2,
+			add: math.MaxInt64/2 + 2, mul: math.MaxInt64 - 1,
+		},
+		{
+			name: "half max times three",
+			a:    math.MaxInt64 / 2, b: 3,
+			add: math.MaxInt64/2 + 3, mul: math.MaxInt64,
+			mulOverflow: true,
+		},
+		{
+			name: "negative product",
+			a:    math.MaxInt64 / 2, b: -3,
+			add: math.MaxInt64/2 - 3, mul: math.MinInt64,
+			mulOverflow: true,
+		},
+		{
+			name: "smallest product",
+			a:    math.MinInt64 / 2, b: 2,
+			add: math.MinInt64/2 + 2, mul: math.MinInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := AddChecked(tt.a, tt.b); (err == ErrOverflow) != tt.addOverflow || (err == nil && got != tt.add) {
+				t.Errorf("AddChecked() = %v, %v want %v", got, err, tt.add)
+			}
+			if got, err := MulChecked(tt.a, tt.b); (err == ErrOverflow) != tt.mulOverflow || (err == nil && got != tt.mul) {
+				t.Errorf("MulChecked() = %v, %v want %v", got, err, tt.mul)
+			}
+			if got := AddSaturating(tt.a, tt.b); got != tt.add {
+				t.Errorf("AddSaturating() = %v, want %v", got, tt.add)
+			}
+			if got := MulSaturating(tt.a, tt.b); got != tt.mul {
+				t.Errorf("MulSaturating() = %v, want %v", got, tt.mul)
+			}
+		})
+	}
+}
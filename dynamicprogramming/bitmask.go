@@ -0,0 +1,181 @@
+package dynamicprogramming
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Bitmask dynamic programming. Subsets of n elements are represented as n bit integers,
+// i'th bit of a mask is set if i'th element is in the subset. Memory usage grows as 2^n, so n should be at most ~20.
+
+// travelling salesman problem: find the cheapest tour that visits every vertex exactly once and returns to the start.
+// Held-Karp: c(S, j) is the cost of the cheapest path that starts at vertex 0, visits every vertex in S and ends at j in S.
+// c(S, j) = min over i in S - {j} of c(S - {j}, i) + w(i, j)
+// hamiltonian path problem: find the cheapest path that visits every vertex exactly once, it can start and end anywhere.
+// assignment problem: n workers and n jobs, cost[i][j] is the cost of worker i doing job j.
+// find the cheapest assignment of a distinct job to each worker.
+
+// WeightFunc returns the weight of the edge between vertices i and j and whether such an edge exists.
+type WeightFunc func(i, j int) (weight int, ok bool)
+
+const unreachable = math.MaxInt64
+
+// SolveTSPHeldKarp solves travelling salesman problem on vertices 0 to n-1 with Held-Karp algorithm.
+// Returns the cost and the tour starting and ending at vertex 0, or false if there is no tour.
+func SolveTSPHeldKarp(n int, weight WeightFunc) (int, []int, bool) {
+	if n == 0 {
+		return 0, nil, false
+	}
+	if n == 1 {
+		return 0, []int{0, 0}, true
+	}
+	c, parent := hamiltonianTable(n, weight, false)
+	full := 1<<uint(n) - 1
+	best, last := unreachable, -1
+	for j := 1; j < n; j++ {
+		if c[full][j] == unreachable {
+			continue
+		}
+		w, ok := weight(j, 0)
+		if !ok {
+			continue
+		}
+		if c[full][j]+w < best {
+			best, last = c[full][j]+w, j
+		}
+	}
+	if last == -1 {
+		return 0, nil, false
+	}
+	tour := append(hamiltonianReconstruct(parent, full, last), 0)
+	return best, tour, true
+}
+
+// SolveHamiltonianPathBitmask finds the cheapest path that visits each of the vertices 0 to n-1 exactly once.
+// Returns the cost and the path, or false if there is no such path.
+func SolveHamiltonianPathBitmask(n int, weight WeightFunc) (int, []int, bool) {
+	if n == 0 {
+		return 0, nil, false
+	}
+	c, parent := hamiltonianTable(n, weight, true)
+	full := 1<<uint(n) - 1
+	best, last := unreachable, -1
+	for j := 0; j < n; j++ {
+		if c[full][j] < best {
+			best, last = c[full][j], j
+		}
+	}
+	if last == -1 {
+		return 0, nil, false
+	}
+	return best, hamiltonianReconstruct(parent, full, last), true
+}
+
+// hamiltonianTable returns c and parent where c[S][j] is the cost of the cheapest path that visits vertices in S and ends at j,
+// and parent[S][j] is the vertex before j on that path, -1 for the first vertex.
+// Paths start at vertex 0 unless anyStart is true.
+func hamiltonianTable(n int, weight WeightFunc, anyStart bool) ([][]int, [][]int8) {
+	c := make([][]int, 1<<uint(n))
+	parent := make([][]int8, 1<<uint(n))
+	for s := range c {
+		c[s] = make([]int, n)
+		parent[s] = make([]int8, n)
+		for j := range c[s] {
+			c[s][j] = unreachable
+			parent[s][j] = -1
+		}
+	}
+	for j := 0; j < n; j++ {
+		if anyStart || j == 0 {
+			c[1<<uint(j)][j] = 0
+		}
+	}
+	var next int
+	for s := 1; s < len(c); s++ {
+		for j := 0; j < n; j++ {
+			if s&(1<<uint(j)) == 0 || c[s][j] == unreachable {
+				continue
+			}
+			// extend the path that ends at j with k
+			for k := 0; k < n; k++ {
+				if s&(1<<uint(k)) != 0 {
+					continue
+				}
+				w, ok := weight(j, k)
+				if !ok {
+					continue
+				}
+				next = s | 1<<uint(k)
+				if c[s][j]+w < c[next][k] {
+					c[next][k] = c[s][j] + w
+					parent[next][k] = int8(j)
+				}
+			}
+		}
+	}
+	return c, parent
+}
+
+// hamiltonianReconstruct returns the path that visits vertices in s and ends at j
+func hamiltonianReconstruct(parent [][]int8, s, j int) []int {
+	path := make([]int, 0)
+	for j != -1 {
+		path = append(path, j)
+		s, j = s&^(1<<uint(j)), int(parent[s][j])
+	}
+	for k, l := 0, len(path)-1; k < l; k, l = k+1, l-1 {
+		path[k], path[l] = path[l], path[k]
+	}
+	return path
+}
+
+// SolveAssignmentBitmask solves assignment problem for a square cost matrix.
+// c[S] is the cost of assigning the jobs in S to the first |S| workers.
+// Returns the cost and the assignment, where assignment[i] is the job of worker i.
+// Panics if cost is not square, see SolveAssignmentBitmaskChecked.
+func SolveAssignmentBitmask(cost [][]int) (int, []int) {
+	c, assignment, err := SolveAssignmentBitmaskChecked(cost)
+	if err != nil {
+		panic(err)
+	}
+	return c, assignment
+}
+
+func solveAssignmentBitmask(cost [][]int) (int, []int) {
+	n := len(cost)
+	c := make([]int, 1<<uint(n))
+	// job[S] is the job that is assigned to the last worker in c[S]
+	job := make([]int, 1<<uint(n))
+	for s := 1; s < len(c); s++ {
+		c[s] = unreachable
+		worker := bits.OnesCount(uint(s)) - 1
+		for j := 0; j < n; j++ {
+			if s&(1<<uint(j)) == 0 {
+				continue
+			}
+			if v := c[s&^(1<<uint(j))] + cost[worker][j]; v < c[s] {
+				c[s] = v
+				job[s] = j
+			}
+		}
+	}
+	assignment := make([]int, n)
+	s := len(c) - 1
+	for worker := n - 1; worker >= 0; worker-- {
+		assignment[worker] = job[s]
+		s &^= 1 << uint(job[s])
+	}
+	return c[len(c)-1], assignment
+}
+
+// SolveAssignmentBitmaskChecked solves assignment problem for a square cost matrix.
+// Returns ErrInvalidDimensions if cost is not square.
+func SolveAssignmentBitmaskChecked(cost [][]int) (int, []int, error) {
+	for _, row := range cost {
+		if len(row) != len(cost) {
+			return 0, nil, ErrInvalidDimensions
+		}
+	}
+	c, assignment := solveAssignmentBitmask(cost)
+	return c, assignment, nil
+}
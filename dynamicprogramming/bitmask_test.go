@@ -0,0 +1,137 @@
+package dynamicprogramming
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSolveAssignmentBitmask(t *testing.T) {
+	tests := []struct {
+		name string
+		cost [][]int
+		want int
+	}{
+		{
+			name: "tc1",
+			cost: [][]int{{9, 2, 7, 8}, {6, 4, 3, 7}, {5, 8, 1, 8}, {7, 6, 9, 4}},
+			want: 13,
+		},
+		{
+			name: "single worker",
+			cost: [][]int{{5}},
+			want: 5,
+		},
+		{
+			name: "no workers",
+			cost: [][]int{},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, assignment := SolveAssignmentBitmask(tt.cost)
+			if got != tt.want {
+				t.Errorf("SolveAssignmentBitmask() = %v, want %v", got, tt.want)
+			}
+			checkAssignment(t, tt.cost, assignment, got)
+		})
+	}
+	// compare with trying every assignment
+	for c := 0; c < 20; c++ {
+		n := 1 + rand.Intn(7)
+		cost := make([][]int, n)
+		for i := range cost {
+			cost[i] = make([]int, n)
+			for j := range cost[i] {
+				cost[i][j] = rand.Intn(50)
+			}
+		}
+		want := -1
+		jobs := make([]int, n)
+		for i := range jobs {
+			jobs[i] = i
+		}
+		var try func(k, sum int)
+		try = func(k, sum int) {
+			if k == n {
+				if want == -1 || sum < want {
+					want = sum
+				}
+				return
+			}
+			for i := k; i < n; i++ {
+				jobs[k], jobs[i] = jobs[i], jobs[k]
+				try(k+1, sum+cost[k][jobs[k]])
+				jobs[k], jobs[i] = jobs[i], jobs[k]
+			}
+		}
+		try(0, 0)
+		got, assignment := SolveAssignmentBitmask(cost)
+		if got != want {
+			t.Fatalf("SolveAssignmentBitmask() = %v, want %v, cost %v", got, want, cost)
+		}
+		checkAssignment(t, cost, assignment, got)
+	}
+}
+
+func checkAssignment(t *testing.T, cost [][]int, assignment []int, want int) {
+	used := make(map[int]bool)
+	total := 0
+	for worker, job := range assignment {
+		if used[job] {
+			t.Fatalf("assignment %v uses job %v twice", assignment, job)
+		}
+		used[job] = true
+		total += cost[worker][job]
+	}
+	if total != want {
+		t.Fatalf("assignment %v costs %v, want %v", assignment, total, want)
+	}
+}
+
+func TestSolveAssignmentBitmaskInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		cost [][]int
+		want error
+	}{
+		{
+			name: "empty",
+			cost: nil,
+			want: nil,
+		},
+		{
+			name: "square",
+			cost: [][]int{{4, 1}, {2, 3}},
+			want: nil,
+		},
+		{
+			name: "more jobs than workers",
+			cost: [][]int{{4, 1, 3}, {2, 3, 1}},
+			want: ErrInvalidDimensions,
+		},
+		{
+			name: "more workers than jobs",
+			cost: [][]int{{4}, {2}},
+			want: ErrInvalidDimensions,
+		},
+		{
+			name: "ragged",
+			cost: [][]int{{4, 1}, {2}},
+			want: ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, assignment, err := SolveAssignmentBitmaskChecked(tt.cost)
+			if err != tt.want {
+				t.Fatalf("SolveAssignmentBitmaskChecked() error = %v, want %v", err, tt.want)
+			}
+			if err != nil {
+				checkPanics(t, "SolveAssignmentBitmask()", err, func() { SolveAssignmentBitmask(tt.cost) })
+				return
+			}
+			checkAssignment(t, tt.cost, assignment, got)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package dynamicprogramming
+
+import "math/big"
+
+// Problem: given coin denominations and an amount, find the minimum number of coins that add up to the amount,
+// and the number of different ways to make up the amount. Each denomination can be used any number of times.
+// Ways are combinations, i.e. 1+2 and 2+1 are the same way.
+
+// coinState is the state of coin change ways problem, amount is made up by using coins[i:]
+type coinState struct {
+	i, amount int
+}
+
+// validateCoins checks that amount is not negative and every coin is positive
+func validateCoins(coins []int, amount int) error {
+	if amount < 0 {
+		return ErrNegativeInput
+	}
+	for _, c := range coins {
+		if c <= 0 {
+			return ErrNonPositiveInput
+		}
+	}
+	return nil
+}
+
+// SolveCoinChangeMinRecursive returns the minimum number of coins that add up to amount with a naive recursive approach.
+// Returns -1 if the amount cannot be made up.
+// Panics if the input is invalid, see SolveCoinChangeMinRecursiveChecked.
+func SolveCoinChangeMinRecursive(coins []int, amount int) int {
+	r, err := SolveCoinChangeMinRecursiveChecked(coins, amount)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveCoinChangeMinRecursiveChecked returns the minimum number of coins that add up to amount with a naive recursive approach.
+// Returns ErrNegativeInput if amount is negative and ErrNonPositiveInput if a coin is not positive.
+func SolveCoinChangeMinRecursiveChecked(coins []int, amount int) (int, error) {
+	if err := validateCoins(coins, amount); err != nil {
+		return 0, err
+	}
+	return Recursive(coinChangeMin(coins))(amount), nil
+}
+
+// SolveCoinChangeMinMemoized returns the minimum number of coins that add up to amount
+// by storing previously calculated values in a memo. Returns -1 if the amount cannot be made up.
+// Panics if the input is invalid, see SolveCoinChangeMinMemoizedChecked.
+func SolveCoinChangeMinMemoized(coins []int, amount int) int {
+	r, err := SolveCoinChangeMinMemoizedChecked(coins, amount)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveCoinChangeMinMemoizedChecked returns the minimum number of coins that add up to amount by using a memo.
+// Returns ErrNegativeInput if amount is negative and ErrNonPositiveInput if a coin is not positive.
+func SolveCoinChangeMinMemoizedChecked(coins []int, amount int) (int, error) {
+	if err := validateCoins(coins, amount); err != nil {
+		return 0, err
+	}
+	return NewMemo(0).Wrap(coinChangeMin(coins))(amount), nil
+}
+
+// coinChangeMin returns minimum coin change recursion for given coins, state is the amount
+func coinChangeMin(coins []int) MemoFunc {
+	return func(self func(state interface{}) int, state interface{}) int {
+		amount := state.(int)
+		if amount == 0 {
+			return 0
+		}
+		q := -1
+		for _, c := range coins {
+			if c <= 0 || c > amount {
+				continue
+			}
+			if r := self(amount - c); r >= 0 && (q == -1 || r+1 < q) {
+				q = r + 1
+			}
+		}
+		return q
+	}
+}
+
+// SolveCoinChangeMinTabulated uses bottom up approach to find the minimum number of coins that add up to amount.
+// Returns the number of coins and the coins used, or -1 and nil if the amount cannot be made up.
+// Panics if the input is invalid, see SolveCoinChangeMinTabulatedChecked.
+func SolveCoinChangeMinTabulated(coins []int, amount int) (int, []int) {
+	r, used, err := SolveCoinChangeMinTabulatedChecked(coins, amount)
+	if err != nil {
+		panic(err)
+	}
+	return r, used
+}
+
+// SolveCoinChangeMinTabulatedChecked uses bottom up approach to find the minimum number of coins that add up to amount.
+// Returns ErrNegativeInput if amount is negative and ErrNonPositiveInput if a coin is not positive.
+func SolveCoinChangeMinTabulatedChecked(coins []int, amount int) (int, []int, error) {
+	if err := validateCoins(coins, amount); err != nil {
+		return 0, nil, err
+	}
+	m := make([]int, amount+1)
+	// last[a] is the last coin added for amount a
+	last := make([]int, amount+1)
+	for a := 1; a <= amount; a++ {
+		m[a] = -1
+		for _, c := range coins {
+			if c <= 0 || c > a || m[a-c] < 0 {
+				continue
+			}
+			if m[a] == -1 || m[a-c]+1 < m[a] {
+				m[a] = m[a-c] + 1
+				last[a] = c
+			}
+		}
+	}
+	if m[amount] < 0 {
+		return -1, nil, nil
+	}
+	used := make([]int, 0, m[amount])
+	for a := amount; a > 0; a -= last[a] {
+		used = append(used, last[a])
+	}
+	return m[amount], used, nil
+}
+
+// SolveCoinChangeWaysRecursive returns the number of ways to make up amount with a naive recursive approach.
+// Panics if the input is invalid, see SolveCoinChangeWaysRecursiveChecked.
+func SolveCoinChangeWaysRecursive(coins []int, amount int) *big.Int {
+	r, err := SolveCoinChangeWaysRecursiveChecked(coins, amount)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveCoinChangeWaysRecursiveChecked returns the number of ways to make up amount with a naive recursive approach.
+// Returns ErrNegativeInput if amount is negative and ErrNonPositiveInput if a coin is not positive.
+func SolveCoinChangeWaysRecursiveChecked(coins []int, amount int) (*big.Int, error) {
+	if err := validateCoins(coins, amount); err != nil {
+		return nil, err
+	}
+	return solveCoinChangeWaysAux(coins, coinState{0, amount}, nil), nil
+}
+
+// SolveCoinChangeWaysMemoized returns the number of ways to make up amount
+// by using a map for storing previously calculated values.
+// Panics if the input is invalid, see SolveCoinChangeWaysMemoizedChecked.
+func SolveCoinChangeWaysMemoized(coins []int, amount int) *big.Int {
+	r, err := SolveCoinChangeWaysMemoizedChecked(coins, amount)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveCoinChangeWaysMemoizedChecked returns the number of ways to make up amount by using a map.
+// Returns ErrNegativeInput if amount is negative and ErrNonPositiveInput if a coin is not positive.
+func SolveCoinChangeWaysMemoizedChecked(coins []int, amount int) (*big.Int, error) {
+	if err := validateCoins(coins, amount); err != nil {
+		return nil, err
+	}
+	return solveCoinChangeWaysAux(coins, coinState{0, amount}, make(map[coinState]*big.Int)), nil
+}
+
+// solveCoinChangeWaysAux either uses coins[s.i] once more or moves on to the next coin. Nothing is remembered if m is nil.
+func solveCoinChangeWaysAux(coins []int, s coinState, m map[coinState]*big.Int) *big.Int {
+	if s.amount == 0 {
+		return big.NewInt(1)
+	}
+	if s.amount < 0 || s.i == len(coins) {
+		return big.NewInt(0)
+	}
+	if r, ok := m[s]; ok {
+		return r
+	}
+	r := solveCoinChangeWaysAux(coins, coinState{s.i + 1, s.amount}, m)
+	if coins[s.i] > 0 {
+		r = new(big.Int).Add(r, solveCoinChangeWaysAux(coins, coinState{s.i, s.amount - coins[s.i]}, m))
+	}
+	if m != nil {
+		m[s] = r
+	}
+	return r
+}
+
+// SolveCoinChangeWaysTabulated uses bottom up approach to find the number of ways to make up amount.
+// Coins are processed one by one so that each combination is counted once.
+// Panics if the input is invalid, see SolveCoinChangeWaysTabulatedChecked.
+func SolveCoinChangeWaysTabulated(coins []int, amount int) *big.Int {
+	r, err := SolveCoinChangeWaysTabulatedChecked(coins, amount)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveCoinChangeWaysTabulatedChecked uses bottom up approach to find the number of ways to make up amount.
+// Returns ErrNegativeInput if amount is negative and ErrNonPositiveInput if a coin is not positive.
+func SolveCoinChangeWaysTabulatedChecked(coins []int, amount int) (*big.Int, error) {
+	if err := validateCoins(coins, amount); err != nil {
+		return nil, err
+	}
+	w := make([]*big.Int, amount+1)
+	for a := range w {
+		w[a] = big.NewInt(0)
+	}
+	w[0].SetInt64(1)
+	for _, c := range coins {
+		if c <= 0 {
+			continue
+		}
+		for a := c; a <= amount; a++ {
+			w[a].Add(w[a], w[a-c])
+		}
+	}
+	return w[amount], nil
+}
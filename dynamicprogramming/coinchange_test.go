@@ -0,0 +1,188 @@
+package dynamicprogramming
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCoinChangeMin(t *testing.T) {
+	type args struct {
+		coins  []int
+		amount int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "tc1",
+			args: args{[]int{1, 2, 5}, 11},
+			want: 3,
+		},
+		{
+			name: "impossible",
+			args: args{[]int{2}, 3},
+			want: -1,
+		},
+		{
+			name: "zero amount",
+			args: args{[]int{1}, 0},
+			want: 0,
+		},
+		{
+			name: "greedy fails",
+			args: args{[]int{1, 3, 4}, 6},
+			want: 2,
+		},
+		{
+			name: "tc2",
+			args: args{[]int{186, 419, 83, 408}, 6249},
+			want: 20,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.args.amount < 100 {
+				if got := SolveCoinChangeMinRecursive(tt.args.coins, tt.args.amount); got != tt.want {
+					t.Errorf("SolveCoinChangeMinRecursive() = %v, want %v", got, tt.want)
+				}
+			}
+			if got := SolveCoinChangeMinMemoized(tt.args.coins, tt.args.amount); got != tt.want {
+				t.Errorf("SolveCoinChangeMinMemoized() = %v, want %v", got, tt.want)
+			}
+			got, used := SolveCoinChangeMinTabulated(tt.args.coins, tt.args.amount)
+			if got != tt.want {
+				t.Errorf("SolveCoinChangeMinTabulated() = %v, want %v", got, tt.want)
+			}
+			if got >= 0 && (len(used) != got || sumOf(used) != tt.args.amount) {
+				t.Errorf("SolveCoinChangeMinTabulated() coins %v do not make up %v", used, tt.args.amount)
+			}
+		})
+	}
+}
+
+func TestCoinChangeWays(t *testing.T) {
+	type args struct {
+		coins  []int
+		amount int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "tc1",
+			args: args{[]int{1, 2, 5}, 5},
+			want: "4",
+		},
+		{
+			name: "impossible",
+			args: args{[]int{2}, 3},
+			want: "0",
+		},
+		{
+			name: "zero amount",
+			args: args{[]int{7}, 0},
+			want: "1",
+		},
+		{
+			name: "us coins",
+			args: args{[]int{1, 5, 10, 25, 50, 100}, 100},
+			want: "293",
+		},
+		{
+			name: "overflows int64",
+			args: args{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, 2000},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tab := SolveCoinChangeWaysTabulated(tt.args.coins, tt.args.amount)
+			if tt.want != "" && tab.String() != tt.want {
+				t.Errorf("SolveCoinChangeWaysTabulated() = %v, want %v", tab, tt.want)
+			}
+			if got := SolveCoinChangeWaysMemoized(tt.args.coins, tt.args.amount); got.Cmp(tab) != 0 {
+				t.Errorf("SolveCoinChangeWaysMemoized() = %v, SolveCoinChangeWaysTabulated() = %v", got, tab)
+			}
+			if tt.args.amount <= 100 {
+				if got := SolveCoinChangeWaysRecursive(tt.args.coins, tt.args.amount); got.Cmp(tab) != 0 {
+					t.Errorf("SolveCoinChangeWaysRecursive() = %v, SolveCoinChangeWaysTabulated() = %v", got, tab)
+				}
+			}
+		})
+	}
+}
+
+func TestCoinChangeRandom(t *testing.T) {
+	for c := 0; c < 50; c++ {
+		// distinct coins and small amounts keep the naive recursion fast enough
+		coins := rand.Perm(10)[:1+rand.Intn(4)]
+		for i := range coins {
+			coins[i]++
+		}
+		amount := rand.Intn(15)
+		want, _ := SolveCoinChangeMinTabulated(coins, amount)
+		if got := SolveCoinChangeMinRecursive(coins, amount); got != want {
+			t.Fatalf("SolveCoinChangeMinRecursive(%v, %v) = %v, want %v", coins, amount, got, want)
+		}
+		if got := SolveCoinChangeMinMemoized(coins, amount); got != want {
+			t.Fatalf("SolveCoinChangeMinMemoized(%v, %v) = %v, want %v", coins, amount, got, want)
+		}
+		ways := SolveCoinChangeWaysTabulated(coins, amount)
+		if got := SolveCoinChangeWaysRecursive(coins, amount); got.Cmp(ways) != 0 {
+			t.Fatalf("SolveCoinChangeWaysRecursive(%v, %v) = %v, want %v", coins, amount, got, ways)
+		}
+		if got := SolveCoinChangeWaysMemoized(coins, amount); got.Cmp(ways) != 0 {
+			t.Fatalf("SolveCoinChangeWaysMemoized(%v, %v) = %v, want %v", coins, amount, got, ways)
+		}
+	}
+}
+
+func TestCoinChangeInvalid(t *testing.T) {
+	type args struct {
+		coins  []int
+		amount int
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{
+			name: "negative amount",
+			args: args{[]int{1, 2}, -3},
+			want: ErrNegativeInput,
+		},
+		{
+			name: "zero coin",
+			args: args{[]int{0, 2}, 3},
+			want: ErrNonPositiveInput,
+		},
+		{
+			name: "negative coin",
+			args: args{[]int{2, -1}, 3},
+			want: ErrNonPositiveInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coins, amount := tt.args.coins, tt.args.amount
+			errs := map[string]error{}
+			_, errs["SolveCoinChangeMinRecursiveChecked()"] = SolveCoinChangeMinRecursiveChecked(coins, amount)
+			_, errs["SolveCoinChangeMinMemoizedChecked()"] = SolveCoinChangeMinMemoizedChecked(coins, amount)
+			_, _, errs["SolveCoinChangeMinTabulatedChecked()"] = SolveCoinChangeMinTabulatedChecked(coins, amount)
+			_, errs["SolveCoinChangeWaysRecursiveChecked()"] = SolveCoinChangeWaysRecursiveChecked(coins, amount)
+			_, errs["SolveCoinChangeWaysMemoizedChecked()"] = SolveCoinChangeWaysMemoizedChecked(coins, amount)
+			_, errs["SolveCoinChangeWaysTabulatedChecked()"] = SolveCoinChangeWaysTabulatedChecked(coins, amount)
+			for name, err := range errs {
+				if err != tt.want {
+					t.Errorf("%v error = %v, want %v", name, err, tt.want)
+				}
+			}
+			checkPanics(t, "SolveCoinChangeMinRecursive()", tt.want, func() { SolveCoinChangeMinRecursive(coins, amount) })
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package dynamicprogramming
+
+import "strconv"
+
+// Digit dynamic programming counts the integers in a range [lo, hi] that satisfy a property of their decimal digits,
+// e.g. digit sum constraints, no repeated digits or divisibility.
+// Numbers are built digit by digit from the most significant one. Property is described by a state machine:
+// the state after a digit depends only on the previous state and the digit, and the number is accepted
+// depending on the final state. Numbers that share a prefix state are counted together,
+// so the work depends on the number of digits and states instead of the size of the range.
+
+// DigitProblem describes a digit dynamic programming problem. States should be comparable, i.e. usable as map keys.
+// Leading zeros are skipped, i.e. Transition is called with the first non-zero digit of a number first,
+// except for the number 0 itself, whose only digit is 0.
+type DigitProblem struct {
+	Start      interface{}                                            // state before any digits
+	Transition func(state interface{}, digit int) (interface{}, bool) // next state, false if no number with this prefix can be accepted
+	Accept     func(state interface{}) bool                           // true if a number whose digits lead to state should be counted
+}
+
+// digitState is the state of the digit engine. tight is true while the prefix is equal to the prefix of the upper bound,
+// started is false while only leading zeros are placed.
+type digitState struct {
+	pos     int
+	state   interface{}
+	tight   bool
+	started bool
+}
+
+// CountDigitProblem returns the number of integers in [lo, hi] that are accepted by p. Negative numbers are not counted.
+func CountDigitProblem(p DigitProblem, lo, hi int) int {
+	if lo < 0 {
+		lo = 0
+	}
+	if hi < lo {
+		return 0
+	}
+	return countDigitProblemUpTo(p, hi) - countDigitProblemUpTo(p, lo-1)
+}
+
+// countDigitProblemUpTo returns the number of integers in [0, n] that are accepted by p
+func countDigitProblemUpTo(p DigitProblem, n int) int {
+	if n < 0 {
+		return 0
+	}
+	count := 0
+	// 0 is the only number that consists of a leading zero
+	if s, ok := p.Transition(p.Start, 0); ok && p.Accept(s) {
+		count++
+	}
+	digits := strconv.Itoa(n)
+	f := func(self func(state interface{}) int, state interface{}) int {
+		s := state.(digitState)
+		if s.pos == len(digits) {
+			if s.started && p.Accept(s.state) {
+				return 1
+			}
+			return 0
+		}
+		limit := 9
+		if s.tight {
+			limit = int(digits[s.pos] - '0')
+		}
+		total := 0
+		for d := 0; d <= limit; d++ {
+			next := digitState{pos: s.pos + 1, state: s.state, tight: s.tight && d == limit, started: s.started || d > 0}
+			if next.started {
+				var ok bool
+				if next.state, ok = p.Transition(s.state, d); !ok {
+					continue
+				}
+			}
+			total += self(next)
+		}
+		return total
+	}
+	return count + NewMemo(0).Wrap(f)(digitState{pos: 0, state: p.Start, tight: true})
+}
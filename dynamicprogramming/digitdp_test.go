@@ -0,0 +1,158 @@
+package dynamicprogramming
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// digitSumProblem accepts numbers whose digit sum is target
+func digitSumProblem(target int) DigitProblem {
+	return DigitProblem{
+		Start: 0,
+		Transition: func(state interface{}, digit int) (interface{}, bool) {
+			sum := state.(int) + digit
+			return sum, sum <= target
+		},
+		Accept: func(state interface{}) bool { return state.(int) == target },
+	}
+}
+
+// distinctDigitsProblem accepts numbers without repeated digits, state is the bitmask of used digits
+var distinctDigitsProblem = DigitProblem{
+	Start: 0,
+	Transition: func(state interface{}, digit int) (interface{}, bool) {
+		used := state.(int)
+		if used&(1<<uint(digit)) != 0 {
+			return nil, false
+		}
+		return used | 1<<uint(digit), true
+	},
+	Accept: func(state interface{}) bool { return true },
+}
+
+// divisibleProblem accepts numbers that are divisible by m, state is the remainder of the prefix
+func divisibleProblem(m int) DigitProblem {
+	return DigitProblem{
+		Start: 0,
+		Transition: func(state interface{}, digit int) (interface{}, bool) {
+			return (state.(int)*10 + digit) % m, true
+		},
+		Accept: func(state interface{}) bool { return state.(int) == 0 },
+	}
+}
+
+func TestCountDigitProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		p      DigitProblem
+		lo, hi int
+		want   int
+	}{
+		{
+			name: "digit sum 1 up to 1000",
+			p:    digitSumProblem(1),
+			lo:   0,
+			hi:   1000,
+			want: 4,
+		},
+		{
+			name: "digit sum 0",
+			p:    digitSumProblem(0),
+			lo:   0,
+			hi:   1000,
+			want: 1,
+		},
+		{
+			name: "distinct digits up to 100",
+			p:    distinctDigitsProblem,
+			lo:   0,
+			hi:   100,
+			want: 91,
+		},
+		{
+			name: "distinct digits in every number",
+			p:    distinctDigitsProblem,
+			lo:   0,
+			hi:   math.MaxInt64,
+			want: 8877691,
+		},
+		{
+			name: "divisible by 7 up to a billion",
+			p:    divisibleProblem(7),
+			lo:   1,
+			hi:   1000000000,
+			want: 142857142,
+		},
+		{
+			name: "empty range",
+			p:    divisibleProblem(7),
+			lo:   10,
+			hi:   5,
+			want: 0,
+		},
+		{
+			name: "negative range",
+			p:    divisibleProblem(7),
+			lo:   -100,
+			hi:   -1,
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountDigitProblem(tt.p, tt.lo, tt.hi); got != tt.want {
+				t.Errorf("CountDigitProblem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCountDigitProblemBruteForce compares the engine with checking every number in small random ranges
+func TestCountDigitProblemBruteForce(t *testing.T) {
+	digitSum := func(n int) int {
+		s := 0
+		for _, c := range strconv.Itoa(n) {
+			s += int(c - '0')
+		}
+		return s
+	}
+	distinct := func(n int) bool {
+		seen := make(map[rune]bool)
+		for _, c := range strconv.Itoa(n) {
+			if seen[c] {
+				return false
+			}
+			seen[c] = true
+		}
+		return true
+	}
+	for c := 0; c < 30; c++ {
+		lo := rand.Intn(5000)
+		hi := lo + rand.Intn(5000)
+		target := rand.Intn(30)
+		m := 1 + rand.Intn(20)
+		var sums, distincts, divisibles int
+		for n := lo; n <= hi; n++ {
+			if digitSum(n) == target {
+				sums++
+			}
+			if distinct(n) {
+				distincts++
+			}
+			if n%m == 0 {
+				divisibles++
+			}
+		}
+		if got := CountDigitProblem(digitSumProblem(target), lo, hi); got != sums {
+			t.Fatalf("digit sum %v in [%v, %v] = %v, want %v", target, lo, hi, got, sums)
+		}
+		if got := CountDigitProblem(distinctDigitsProblem, lo, hi); got != distincts {
+			t.Fatalf("distinct digits in [%v, %v] = %v, want %v", lo, hi, got, distincts)
+		}
+		if got := CountDigitProblem(divisibleProblem(m), lo, hi); got != divisibles {
+			t.Fatalf("divisible by %v in [%v, %v] = %v, want %v", m, lo, hi, got, divisibles)
+		}
+	}
+}
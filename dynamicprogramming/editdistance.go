@@ -0,0 +1,251 @@
+package dynamicprogramming
+
+// Problem: find the minimum number of single byte edits (insertions, deletions or substitutions)
+// that are required to change a into b. This is called Levenshtein distance.
+// Damerau-Levenshtein distance also allows transposition of two adjacent bytes.
+// Damerau-Levenshtein solvers below compute the optimal string alignment distance,
+// i.e. no substring is edited more than once.
+
+// EditOperation is a single step of an edit script that transforms a into b
+type EditOperation byte
+
+// Edit operations. Match is not an edit, it is included so that a script walks through both inputs completely.
+const (
+	EditMatch      EditOperation = 'M'
+	EditSubstitute EditOperation = 'S'
+	EditInsert     EditOperation = 'I'
+	EditDelete     EditOperation = 'D'
+	EditTranspose  EditOperation = 'T'
+)
+
+func (o EditOperation) String() string {
+	return string(o)
+}
+
+// SolveLevenshteinRecursive returns the Levenshtein distance between a and b with a naive recursive approach.
+func SolveLevenshteinRecursive(a, b []byte) int {
+	if len(a) == 0 {
+		return len(b)
+	}
+	if len(b) == 0 {
+		return len(a)
+	}
+	cost := 1
+	if a[len(a)-1] == b[len(b)-1] {
+		cost = 0
+	}
+	d := SolveLevenshteinRecursive(a[:len(a)-1], b[:len(b)-1]) + cost
+	if r := SolveLevenshteinRecursive(a[:len(a)-1], b) + 1; r < d {
+		d = r
+	}
+	if r := SolveLevenshteinRecursive(a, b[:len(b)-1]) + 1; r < d {
+		d = r
+	}
+	return d
+}
+
+// SolveLevenshteinMemoized returns the Levenshtein distance between a and b
+// by using a slice of slices for storing previously calculated values.
+func SolveLevenshteinMemoized(a, b []byte) int {
+	return solveEditDistanceMemoized(a, b, false)
+}
+
+// SolveLevenshteinTabulated uses bottom up approach to find the Levenshtein distance between a and b.
+// Returns the distance and an edit script that transforms a into b.
+func SolveLevenshteinTabulated(a, b []byte) (int, []EditOperation) {
+	return solveEditDistanceTabulated(a, b, false)
+}
+
+// SolveDamerauLevenshteinRecursive returns the Damerau-Levenshtein (optimal string alignment) distance
+// between a and b with a naive recursive approach.
+func SolveDamerauLevenshteinRecursive(a, b []byte) int {
+	if len(a) == 0 {
+		return len(b)
+	}
+	if len(b) == 0 {
+		return len(a)
+	}
+	i, j := len(a), len(b)
+	cost := 1
+	if a[i-1] == b[j-1] {
+		cost = 0
+	}
+	d := SolveDamerauLevenshteinRecursive(a[:i-1], b[:j-1]) + cost
+	if r := SolveDamerauLevenshteinRecursive(a[:i-1], b) + 1; r < d {
+		d = r
+	}
+	if r := SolveDamerauLevenshteinRecursive(a, b[:j-1]) + 1; r < d {
+		d = r
+	}
+	if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+		if r := SolveDamerauLevenshteinRecursive(a[:i-2], b[:j-2]) + 1; r < d {
+			d = r
+		}
+	}
+	return d
+}
+
+// SolveDamerauLevenshteinMemoized returns the Damerau-Levenshtein (optimal string alignment) distance between a and b
+// by using a slice of slices for storing previously calculated values.
+func SolveDamerauLevenshteinMemoized(a, b []byte) int {
+	return solveEditDistanceMemoized(a, b, true)
+}
+
+// solveEditDistanceMemoized allows transpositions only if transpose is true.
+// m[i][j] holds the distance between a[:i] and b[:j], -1 means not calculated yet.
+func solveEditDistanceMemoized(a, b []byte, transpose bool) int {
+	m := make([][]int, len(a)+1)
+	for i := range m {
+		m[i] = make([]int, len(b)+1)
+		for j := range m[i] {
+			m[i][j] = -1
+		}
+	}
+	return solveEditDistanceMemoizedAux(a, b, len(a), len(b), transpose, m)
+}
+
+func solveEditDistanceMemoizedAux(a, b []byte, i, j int, transpose bool, m [][]int) int {
+	if i == 0 {
+		return j
+	}
+	if j == 0 {
+		return i
+	}
+	if m[i][j] >= 0 {
+		return m[i][j]
+	}
+	cost := 1
+	if a[i-1] == b[j-1] {
+		cost = 0
+	}
+	d := solveEditDistanceMemoizedAux(a, b, i-1, j-1, transpose, m) + cost
+	if r := solveEditDistanceMemoizedAux(a, b, i-1, j, transpose, m) + 1; r < d {
+		d = r
+	}
+	if r := solveEditDistanceMemoizedAux(a, b, i, j-1, transpose, m) + 1; r < d {
+		d = r
+	}
+	if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+		if r := solveEditDistanceMemoizedAux(a, b, i-2, j-2, transpose, m) + 1; r < d {
+			d = r
+		}
+	}
+	m[i][j] = d
+	return d
+}
+
+// SolveDamerauLevenshteinTabulated uses bottom up approach to find the Damerau-Levenshtein (optimal string alignment)
+// distance between a and b. Returns the distance and an edit script that transforms a into b.
+func SolveDamerauLevenshteinTabulated(a, b []byte) (int, []EditOperation) {
+	return solveEditDistanceTabulated(a, b, true)
+}
+
+func solveEditDistanceTabulated(a, b []byte, transpose bool) (int, []EditOperation) {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	var cost, r int
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost = 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = d[i-1][j-1] + cost
+			if r = d[i-1][j] + 1; r < d[i][j] {
+				d[i][j] = r
+			}
+			if r = d[i][j-1] + 1; r < d[i][j] {
+				d[i][j] = r
+			}
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if r = d[i-2][j-2] + 1; r < d[i][j] {
+					d[i][j] = r
+				}
+			}
+		}
+	}
+
+	// walk back from the bottom right corner, the script is built in reverse
+	script := make([]EditOperation, 0, len(a)+len(b))
+	i, j := len(a), len(b)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && a[i-1] == b[j-1] && d[i][j] == d[i-1][j-1]:
+			script = append(script, EditMatch)
+			i--
+			j--
+		case i > 0 && j > 0 && d[i][j] == d[i-1][j-1]+1:
+			script = append(script, EditSubstitute)
+			i--
+			j--
+		case i > 0 && d[i][j] == d[i-1][j]+1:
+			script = append(script, EditDelete)
+			i--
+		case j > 0 && d[i][j] == d[i][j-1]+1:
+			script = append(script, EditInsert)
+			j--
+		default:
+			// only a transposition can lead here
+			script = append(script, EditTranspose)
+			i -= 2
+			j -= 2
+		}
+	}
+	for k, l := 0, len(script)-1; k < l; k, l = k+1, l-1 {
+		script[k], script[l] = script[l], script[k]
+	}
+	return d[len(a)][len(b)], script
+}
+
+// SolveLevenshteinDPSO returns the Levenshtein distance between a and b by keeping only two rows of the table.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveLevenshteinDPSO(a, b []byte) int {
+	return solveEditDistanceDPSO(a, b, false)
+}
+
+// SolveDamerauLevenshteinDPSO returns the optimal string alignment distance between a and b by keeping only three rows of the table,
+// transpositions look two rows back.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveDamerauLevenshteinDPSO(a, b []byte) int {
+	return solveEditDistanceDPSO(a, b, true)
+}
+
+func solveEditDistanceDPSO(a, b []byte, transpose bool) int {
+	// before, prev and cur are rows i-2, i-1 and i of the table
+	before := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	var cost, r int
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost = 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if r = prev[j] + 1; r < cur[j] {
+				cur[j] = r
+			}
+			if r = cur[j-1] + 1; r < cur[j] {
+				cur[j] = r
+			}
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if r = before[j-2] + 1; r < cur[j] {
+					cur[j] = r
+				}
+			}
+		}
+		before, prev, cur = prev, cur, before
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,184 @@
+package dynamicprogramming
+
+import (
+	"strings"
+	"testing"
+)
+
+// applyEditScript walks through a and b following the script.
+// Returns the number of edits or -1 if the script does not transform a into b.
+func applyEditScript(a, b []byte, script []EditOperation) int {
+	i, j, edits := 0, 0, 0
+	for _, op := range script {
+		switch op {
+		case EditMatch:
+			if i >= len(a) || j >= len(b) || a[i] != b[j] {
+				return -1
+			}
+			i++
+			j++
+		case EditSubstitute:
+			if i >= len(a) || j >= len(b) {
+				return -1
+			}
+			i++
+			j++
+		case EditInsert:
+			if j >= len(b) {
+				return -1
+			}
+			j++
+		case EditDelete:
+			if i >= len(a) {
+				return -1
+			}
+			i++
+		case EditTranspose:
+			if i+1 >= len(a) || j+1 >= len(b) || a[i] != b[j+1] || a[i+1] != b[j] {
+				return -1
+			}
+			i += 2
+			j += 2
+		default:
+			return -1
+		}
+		if op != EditMatch {
+			edits++
+		}
+	}
+	if i != len(a) || j != len(b) {
+		return -1
+	}
+	return edits
+}
+
+func TestLevenshtein(t *testing.T) {
+	type args struct {
+		a, b []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "kitten sitting",
+			args: args{[]byte("kitten"), []byte("sitting")},
+			want: 3,
+		},
+		{
+			name: "saturday sunday",
+			args: args{[]byte("saturday"), []byte("sunday")},
+			want: 3,
+		},
+		{
+			name: "empty",
+			args: args{[]byte(""), []byte("abc")},
+			want: 3,
+		},
+		{
+			name: "equal",
+			args: args{[]byte("abc"), []byte("abc")},
+			want: 0,
+		},
+		{
+			name: "transposition",
+			args: args{[]byte("ca"), []byte("ac")},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveLevenshteinRecursive(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveLevenshteinRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveLevenshteinMemoized(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveLevenshteinMemoized() = %v, want %v", got, tt.want)
+			}
+			if got := SolveLevenshteinDPSO(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveLevenshteinDPSO() = %v, want %v", got, tt.want)
+			}
+			got, script := SolveLevenshteinTabulated(tt.args.a, tt.args.b)
+			if got != tt.want {
+				t.Errorf("SolveLevenshteinTabulated() = %v, want %v", got, tt.want)
+			}
+			if edits := applyEditScript(tt.args.a, tt.args.b, script); edits != tt.want {
+				t.Errorf("SolveLevenshteinTabulated() script %v has %v edits, want %v", script, edits, tt.want)
+			}
+		})
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	type args struct {
+		a, b []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "kitten sitting",
+			args: args{[]byte("kitten"), []byte("sitting")},
+			want: 3,
+		},
+		{
+			name: "transposition",
+			args: args{[]byte("ca"), []byte("ac")},
+			want: 1,
+		},
+		{
+			name: "optimal string alignment",
+			args: args{[]byte("ca"), []byte("abc")},
+			want: 3,
+		},
+		{
+			name: "typos",
+			args: args{[]byte("algorithm"), []byte("algortihm")},
+			want: 1,
+		},
+		{
+			name: "empty",
+			args: args{[]byte("abc"), []byte("")},
+			want: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveDamerauLevenshteinRecursive(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveDamerauLevenshteinRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveDamerauLevenshteinMemoized(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveDamerauLevenshteinMemoized() = %v, want %v", got, tt.want)
+			}
+			if got := SolveDamerauLevenshteinDPSO(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveDamerauLevenshteinDPSO() = %v, want %v", got, tt.want)
+			}
+			got, script := SolveDamerauLevenshteinTabulated(tt.args.a, tt.args.b)
+			if got != tt.want {
+				t.Errorf("SolveDamerauLevenshteinTabulated() = %v, want %v", got, tt.want)
+			}
+			if edits := applyEditScript(tt.args.a, tt.args.b, script); edits != tt.want {
+				t.Errorf("SolveDamerauLevenshteinTabulated() script %v has %v edits, want %v", script, edits, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkEditDistance(b *testing.B) {
+	x := []byte(strings.Repeat("kitten sitting on a mat ", 50))
+	y := []byte(strings.Repeat("sitting kitten at a mat ", 50))
+	b.Run("Tabulated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveDamerauLevenshteinTabulated(x, y)
+		}
+	})
+	b.Run("DPSO", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveDamerauLevenshteinDPSO(x, y)
+		}
+	})
+}
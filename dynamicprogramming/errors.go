@@ -0,0 +1,27 @@
+// Package dynamicprogramming solves classic dynamic programming problems, most of them in several ways,
+// e.g. with plain recursion, a memo, a table or a space optimized table.
+//
+// Solvers that validate their input come in pairs: the Checked variant returns one of the errors below
+// and the variant without an error return panics with the same error.
+// Rod cutting, matrix chain multiplication and max number with signs are the exception: their variants without an error return
+// do not validate prices, dimensions and numbers, e.g. negative numbers are added and multiplied like any others.
+// Results that do not fit into an int make the variants without an error return panic with ErrOverflow.
+package dynamicprogramming
+
+import "errors"
+
+var (
+	// ErrNegativeInput is returned when an index, length, capacity, amount, price or value is negative
+	ErrNegativeInput = errors.New("negative input")
+	// ErrNonPositiveInput is returned when an item weight or a coin is zero or negative, which would never make progress
+	ErrNonPositiveInput = errors.New("non-positive input")
+	// ErrInvalidDimensions is returned when matrix dimensions do not describe at least one matrix,
+	// or the lengths of inputs do not match
+	ErrInvalidDimensions = errors.New("invalid dimensions")
+	// ErrOutOfRange is returned when an input exceeds the range that the other inputs cover, e.g. a rod longer than its price list
+	ErrOutOfRange = errors.New("input out of range")
+	// ErrOverflow is returned when a result or an intermediate value does not fit into an int
+	ErrOverflow = errors.New("integer overflow")
+	// ErrUnsortedKeys is returned when keys are not distinct and in increasing order
+	ErrUnsortedKeys = errors.New("keys are not sorted")
+)
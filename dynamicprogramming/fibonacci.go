@@ -1,28 +1,67 @@
 package dynamicprogramming
 
+import "math/big"
+
+// maxFibonacciInt64 is the index of the largest fibonacci number that fits into an int64
+const maxFibonacciInt64 = 92
+
 // SolveFibonacciRecursive returns n'th (0 indexed) fibonacci number by using a simple recursive approach
+// Panics if n is negative. For n greater than 92 it panics with ErrOverflow instead of returning a wrapped around result
+// as it did before, see SolveFibonacciRecursiveChecked and SolveFibonacciBig.
 func SolveFibonacciRecursive(n int64) int64 {
+	r, err := SolveFibonacciRecursiveChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveFibonacciRecursiveChecked returns n'th (0 indexed) fibonacci number by using a simple recursive approach
+// Returns ErrNegativeInput if n is negative and ErrOverflow if the result does not fit into an int64.
+func SolveFibonacciRecursiveChecked(n int64) (int64, error) {
 	if n < 0 {
-		panic("invalid index")
+		return 0, ErrNegativeInput
+	}
+	if n > maxFibonacciInt64 {
+		return 0, ErrOverflow
 	}
+	return solveFibonacciRecursiveAux(n), nil
+}
+
+func solveFibonacciRecursiveAux(n int64) int64 {
 	if n <= 1 {
 		return n
 	}
-	return SolveFibonacciRecursive(n-1) + SolveFibonacciRecursive(n-2)
+	return solveFibonacciRecursiveAux(n-1) + solveFibonacciRecursiveAux(n-2)
 }
 
 // SolveFibonacciDP eturns n'th (0 indexed) fibonacci number by using an array to store pre-computed values
+// Panics if n is negative. For n greater than 92 it panics with ErrOverflow instead of returning a wrapped around result
+// as it did before, see SolveFibonacciDPChecked and SolveFibonacciBig.
 func SolveFibonacciDP(n int64) int64 {
+	r, err := SolveFibonacciDPChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveFibonacciDPChecked returns n'th (0 indexed) fibonacci number by using an array to store pre-computed values
+// Returns ErrNegativeInput if n is negative and ErrOverflow if the result does not fit into an int64.
+func SolveFibonacciDPChecked(n int64) (int64, error) {
 	if n < 0 {
-		panic("invalid index")
+		return 0, ErrNegativeInput
+	}
+	if n > maxFibonacciInt64 {
+		return 0, ErrOverflow
 	}
 	if n <= 1 {
-		return n
+		return n, nil
 	}
 	f := make([]int64, n+1)
 	f[0] = 0
 	f[1] = 1
-	return solveFibonacciDPAux(n-1, f) + solveFibonacciDPAux(n-2, f)
+	return solveFibonacciDPAux(n-1, f) + solveFibonacciDPAux(n-2, f), nil
 }
 
 func solveFibonacciDPAux(n int64, f []int64) int64 {
@@ -39,12 +78,27 @@ func solveFibonacciDPAux(n int64, f []int64) int64 {
 
 // SolveFibonacciDPSO returns n'th (0 indexed) fibonacci number by using an array to store previous two pre-computed values
 // DPSO stands for Dynamic Programming & Space Optimized
+// Panics if n is negative. For n greater than 92 it panics with ErrOverflow instead of returning a wrapped around result
+// as it did before, see SolveFibonacciDPSOChecked and SolveFibonacciBig.
 func SolveFibonacciDPSO(n int64) int64 {
+	r, err := SolveFibonacciDPSOChecked(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveFibonacciDPSOChecked returns n'th (0 indexed) fibonacci number by using an array to store previous two pre-computed values
+// Returns ErrNegativeInput if n is negative and ErrOverflow if the result does not fit into an int64.
+func SolveFibonacciDPSOChecked(n int64) (int64, error) {
 	if n < 0 {
-		panic("invalid index")
+		return 0, ErrNegativeInput
+	}
+	if n > maxFibonacciInt64 {
+		return 0, ErrOverflow
 	}
 	if n <= 1 {
-		return n
+		return n, nil
 	}
 	f := make([]int64, 2)
 	f[0] = 0
@@ -52,5 +106,19 @@ func SolveFibonacciDPSO(n int64) int64 {
 	for i := int64(2); i < n; i++ {
 		f[0], f[1] = f[1], f[0]+f[1]
 	}
-	return f[0] + f[1]
+	return f[0] + f[1], nil
+}
+
+// SolveFibonacciBig returns n'th (0 indexed) fibonacci number of any size by keeping the previous two values.
+// Returns ErrNegativeInput if n is negative.
+func SolveFibonacciBig(n int64) (*big.Int, error) {
+	if n < 0 {
+		return nil, ErrNegativeInput
+	}
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := int64(0); i < n; i++ {
+		a.Add(a, b)
+		a, b = b, a
+	}
+	return a, nil
 }
@@ -99,3 +99,51 @@ func TestSolveFibonacciDP(t *testing.T) {
 		})
 	}
 }
+
+func TestSolveFibonacciNegative(t *testing.T) {
+	if _, err := SolveFibonacciRecursiveChecked(-1); err != ErrNegativeInput {
+		t.Errorf("SolveFibonacciRecursiveChecked() error = %v, want %v", err, ErrNegativeInput)
+	}
+	if _, err := SolveFibonacciDPChecked(-1); err != ErrNegativeInput {
+		t.Errorf("SolveFibonacciDPChecked() error = %v, want %v", err, ErrNegativeInput)
+	}
+	if _, err := SolveFibonacciDPSOChecked(-1); err != ErrNegativeInput {
+		t.Errorf("SolveFibonacciDPSOChecked() error = %v, want %v", err, ErrNegativeInput)
+	}
+	checkPanics(t, "SolveFibonacciRecursive()", ErrNegativeInput, func() { SolveFibonacciRecursive(-1) })
+	checkPanics(t, "SolveFibonacciDP()", ErrNegativeInput, func() { SolveFibonacciDP(-1) })
+	checkPanics(t, "SolveFibonacciDPSO()", ErrNegativeInput, func() { SolveFibonacciDPSO(-1) })
+}
+
+// checkPanics checks that f panics with err
+func checkPanics(t *testing.T, name string, err error, f func()) {
+	defer func() {
+		if r := recover(); r != err {
+			t.Errorf("%v panic = %v, want %v", name, r, err)
+		}
+	}()
+	f()
+}
+
+func TestSolveFibonacciOverflow(t *testing.T) {
+	if got, err := SolveFibonacciDPSOChecked(92); got != 7540113804746346429 || err != nil {
+		t.Errorf("SolveFibonacciDPSOChecked() = %v, %v want %v", got, err, int64(7540113804746346429))
+	}
+	if _, err := SolveFibonacciDPSOChecked(93); err != ErrOverflow {
+		t.Errorf("SolveFibonacciDPSOChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := SolveFibonacciDPChecked(93); err != ErrOverflow {
+		t.Errorf("SolveFibonacciDPChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := SolveFibonacciRecursiveChecked(93); err != ErrOverflow {
+		t.Errorf("SolveFibonacciRecursiveChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	for n, want := range map[int64]string{0: "0", 1: "1", 92: "7540113804746346429", 100: "354224848179261915075"} {
+		if got, err := SolveFibonacciBig(n); err != nil || got.String() != want {
+			t.Errorf("SolveFibonacciBig(%v) = %v, %v want %v", n, got, err, want)
+		}
+	}
+	if _, err := SolveFibonacciBig(-1); err != ErrNegativeInput {
+		t.Errorf("SolveFibonacciBig() error = %v, want %v", err, ErrNegativeInput)
+	}
+}
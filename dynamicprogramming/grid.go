@@ -0,0 +1,400 @@
+package dynamicprogramming
+
+import "math/big"
+
+// Grid problems. A robot starts at the top left cell of a grid and moves only right or down
+// until it reaches the bottom right cell.
+// unique paths: count the paths that avoid obstacles.
+// minimum path sum: find the path with the minimum total cost.
+// dungeon: each cell adds to or takes from the robot's health, find the minimum initial health
+// that keeps health at least 1 all the way.
+// maximal square and rectangle: find the largest square or rectangle that consists of ones only.
+
+// Grid is a rectangular grid of integers, Grid[r][c] is the cell at row r and column c.
+type Grid [][]int
+
+// Cell is a coordinate in a Grid
+type Cell struct {
+	Row, Col int
+}
+
+func (g Grid) rows() int {
+	return len(g)
+}
+
+func (g Grid) cols() int {
+	if len(g) == 0 {
+		return 0
+	}
+	return len(g[0])
+}
+
+// validateGrid checks that every row of the grid has the same number of columns
+func validateGrid(g Grid) error {
+	for _, row := range g {
+		if len(row) != g.cols() {
+			return ErrInvalidDimensions
+		}
+	}
+	return nil
+}
+
+// SolveUniquePathsRecursive returns the number of paths from top left to bottom right with a naive recursive approach.
+// Cells with non-zero values are obstacles.
+// Panics if the rows of the grid have different lengths, see SolveUniquePathsRecursiveChecked.
+func SolveUniquePathsRecursive(g Grid) *big.Int {
+	r, err := SolveUniquePathsRecursiveChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func solveUniquePathsRecursive(g Grid) *big.Int {
+	if g.rows() == 0 || g.cols() == 0 {
+		return big.NewInt(0)
+	}
+	return solveUniquePathsRecursiveAux(g, g.rows()-1, g.cols()-1)
+}
+
+// SolveUniquePathsRecursiveChecked returns the number of paths from top left to bottom right with a naive recursive approach.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveUniquePathsRecursiveChecked(g Grid) (*big.Int, error) {
+	if err := validateGrid(g); err != nil {
+		return nil, err
+	}
+	return solveUniquePathsRecursive(g), nil
+}
+
+func solveUniquePathsRecursiveAux(g Grid, r, c int) *big.Int {
+	if r < 0 || c < 0 || g[r][c] != 0 {
+		return big.NewInt(0)
+	}
+	if r == 0 && c == 0 {
+		return big.NewInt(1)
+	}
+	return new(big.Int).Add(solveUniquePathsRecursiveAux(g, r-1, c), solveUniquePathsRecursiveAux(g, r, c-1))
+}
+
+// SolveUniquePathsTabulated uses bottom up approach to count the paths from top left to bottom right.
+// Cells with non-zero values are obstacles.
+// Panics if the rows of the grid have different lengths, see SolveUniquePathsTabulatedChecked.
+func SolveUniquePathsTabulated(g Grid) *big.Int {
+	r, err := SolveUniquePathsTabulatedChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func solveUniquePathsTabulated(g Grid) *big.Int {
+	if g.rows() == 0 || g.cols() == 0 {
+		return big.NewInt(0)
+	}
+	// a single row is enough, p[c] holds the number of paths to cell (r, c) once row r is processed
+	p := make([]*big.Int, g.cols())
+	for c := range p {
+		p[c] = big.NewInt(0)
+	}
+	p[0].SetInt64(1)
+	for r := 0; r < g.rows(); r++ {
+		for c := 0; c < g.cols(); c++ {
+			if g[r][c] != 0 {
+				p[c].SetInt64(0)
+			} else if c > 0 {
+				p[c].Add(p[c], p[c-1])
+			}
+		}
+	}
+	return p[g.cols()-1]
+}
+
+// SolveUniquePathsTabulatedChecked uses bottom up approach to count the paths from top left to bottom right.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveUniquePathsTabulatedChecked(g Grid) (*big.Int, error) {
+	if err := validateGrid(g); err != nil {
+		return nil, err
+	}
+	return solveUniquePathsTabulated(g), nil
+}
+
+// SolveMinPathSumRecursive returns the minimum cost of a path from top left to bottom right with a naive recursive approach.
+// Panics if the rows of the grid have different lengths, see SolveMinPathSumRecursiveChecked.
+func SolveMinPathSumRecursive(g Grid) int {
+	r, err := SolveMinPathSumRecursiveChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func solveMinPathSumRecursive(g Grid) int {
+	if g.rows() == 0 || g.cols() == 0 {
+		return 0
+	}
+	return solveMinPathSumRecursiveAux(g, g.rows()-1, g.cols()-1)
+}
+
+// SolveMinPathSumRecursiveChecked returns the minimum cost of a path from top left to bottom right with a naive recursive approach.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveMinPathSumRecursiveChecked(g Grid) (int, error) {
+	if err := validateGrid(g); err != nil {
+		return 0, err
+	}
+	return solveMinPathSumRecursive(g), nil
+}
+
+func solveMinPathSumRecursiveAux(g Grid, r, c int) int {
+	if r == 0 && c == 0 {
+		return g[0][0]
+	}
+	if r == 0 {
+		return g[r][c] + solveMinPathSumRecursiveAux(g, r, c-1)
+	}
+	if c == 0 {
+		return g[r][c] + solveMinPathSumRecursiveAux(g, r-1, c)
+	}
+	up := solveMinPathSumRecursiveAux(g, r-1, c)
+	left := solveMinPathSumRecursiveAux(g, r, c-1)
+	if up < left {
+		return g[r][c] + up
+	}
+	return g[r][c] + left
+}
+
+// SolveMinPathSumTabulated uses bottom up approach to find the minimum cost path from top left to bottom right.
+// Returns the cost and the cells of the path in order.
+// Panics if the rows of the grid have different lengths, see SolveMinPathSumTabulatedChecked.
+func SolveMinPathSumTabulated(g Grid) (int, []Cell) {
+	r, path, err := SolveMinPathSumTabulatedChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r, path
+}
+
+func solveMinPathSumTabulated(g Grid) (int, []Cell) {
+	if g.rows() == 0 || g.cols() == 0 {
+		return 0, nil
+	}
+	m := make([][]int, g.rows())
+	for r := range m {
+		m[r] = make([]int, g.cols())
+		for c := range m[r] {
+			switch {
+			case r == 0 && c == 0:
+				m[r][c] = g[r][c]
+			case r == 0:
+				m[r][c] = g[r][c] + m[r][c-1]
+			case c == 0:
+				m[r][c] = g[r][c] + m[r-1][c]
+			case m[r-1][c] < m[r][c-1]:
+				m[r][c] = g[r][c] + m[r-1][c]
+			default:
+				m[r][c] = g[r][c] + m[r][c-1]
+			}
+		}
+	}
+	path := make([]Cell, g.rows()+g.cols()-1)
+	r, c := g.rows()-1, g.cols()-1
+	for i := len(path) - 1; i >= 0; i-- {
+		path[i] = Cell{r, c}
+		if c == 0 || (r > 0 && m[r-1][c] < m[r][c-1]) {
+			r--
+		} else {
+			c--
+		}
+	}
+	return m[g.rows()-1][g.cols()-1], path
+}
+
+// SolveMinPathSumTabulatedChecked uses bottom up approach to find the minimum cost path from top left to bottom right.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveMinPathSumTabulatedChecked(g Grid) (int, []Cell, error) {
+	if err := validateGrid(g); err != nil {
+		return 0, nil, err
+	}
+	r, path := solveMinPathSumTabulated(g)
+	return r, path, nil
+}
+
+// SolveDungeonTabulated finds the minimum initial health that is needed to go from top left to bottom right
+// so that health never drops below 1. Each cell's value is added to health once the cell is entered, including the first one.
+// The table is filled backwards, since the health needed at a cell depends on the cells after it.
+// Returns the minimum initial health and the cells of the path in order.
+// Panics if the rows of the grid have different lengths, see SolveDungeonTabulatedChecked.
+func SolveDungeonTabulated(g Grid) (int, []Cell) {
+	r, path, err := SolveDungeonTabulatedChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r, path
+}
+
+func solveDungeonTabulated(g Grid) (int, []Cell) {
+	if g.rows() == 0 || g.cols() == 0 {
+		return 1, nil
+	}
+	rows, cols := g.rows(), g.cols()
+	// h[r][c] is the minimum health needed when entering cell (r, c)
+	h := make([][]int, rows)
+	for r := range h {
+		h[r] = make([]int, cols)
+	}
+	var next int
+	for r := rows - 1; r >= 0; r-- {
+		for c := cols - 1; c >= 0; c-- {
+			switch {
+			case r == rows-1 && c == cols-1:
+				next = 1
+			case r == rows-1:
+				next = h[r][c+1]
+			case c == cols-1:
+				next = h[r+1][c]
+			case h[r+1][c] < h[r][c+1]:
+				next = h[r+1][c]
+			default:
+				next = h[r][c+1]
+			}
+			h[r][c] = next - g[r][c]
+			if h[r][c] < 1 {
+				h[r][c] = 1
+			}
+		}
+	}
+	path := make([]Cell, 0, rows+cols-1)
+	r, c := 0, 0
+	for {
+		path = append(path, Cell{r, c})
+		if r == rows-1 && c == cols-1 {
+			break
+		}
+		if c == cols-1 || (r < rows-1 && h[r+1][c] < h[r][c+1]) {
+			r++
+		} else {
+			c++
+		}
+	}
+	return h[0][0], path
+}
+
+// SolveDungeonTabulatedChecked finds the minimum initial health that is needed to go from top left to bottom right.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveDungeonTabulatedChecked(g Grid) (int, []Cell, error) {
+	if err := validateGrid(g); err != nil {
+		return 0, nil, err
+	}
+	r, path := solveDungeonTabulated(g)
+	return r, path, nil
+}
+
+// SolveMaximalSquareTabulated finds the largest square that consists of cells with value 1 only.
+// s[r][c] is the side of the largest square whose bottom right cell is (r, c),
+// which is one more than the smallest of the squares at its top, left and top left neighbours.
+// Returns the side of the square and its top left cell.
+// Panics if the rows of the grid have different lengths, see SolveMaximalSquareTabulatedChecked.
+func SolveMaximalSquareTabulated(g Grid) (int, Cell) {
+	r, at, err := SolveMaximalSquareTabulatedChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r, at
+}
+
+func solveMaximalSquareTabulated(g Grid) (int, Cell) {
+	s := make([][]int, g.rows())
+	best, at := 0, Cell{}
+	for r := range s {
+		s[r] = make([]int, g.cols())
+		for c := range s[r] {
+			if g[r][c] != 1 {
+				continue
+			}
+			s[r][c] = 1
+			if r > 0 && c > 0 {
+				m := s[r-1][c]
+				if s[r][c-1] < m {
+					m = s[r][c-1]
+				}
+				if s[r-1][c-1] < m {
+					m = s[r-1][c-1]
+				}
+				s[r][c] = m + 1
+			}
+			if s[r][c] > best {
+				best = s[r][c]
+				at = Cell{r - best + 1, c - best + 1}
+			}
+		}
+	}
+	return best, at
+}
+
+// SolveMaximalSquareTabulatedChecked finds the largest square that consists of cells with value 1 only.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveMaximalSquareTabulatedChecked(g Grid) (int, Cell, error) {
+	if err := validateGrid(g); err != nil {
+		return 0, Cell{}, err
+	}
+	r, at := solveMaximalSquareTabulated(g)
+	return r, at, nil
+}
+
+// SolveMaximalRectangleTabulated finds the largest rectangle that consists of cells with value 1 only.
+// Each row is treated as the base of a histogram, heights[c] is the number of consecutive ones that end at the row in column c,
+// and the largest rectangle in each histogram is found with a stack of increasing heights.
+// Returns the area of the rectangle, its top left and bottom right cells.
+// Panics if the rows of the grid have different lengths, see SolveMaximalRectangleTabulatedChecked.
+func SolveMaximalRectangleTabulated(g Grid) (int, Cell, Cell) {
+	r, topLeft, bottomRight, err := SolveMaximalRectangleTabulatedChecked(g)
+	if err != nil {
+		panic(err)
+	}
+	return r, topLeft, bottomRight
+}
+
+func solveMaximalRectangleTabulated(g Grid) (int, Cell, Cell) {
+	heights := make([]int, g.cols())
+	best := 0
+	var topLeft, bottomRight Cell
+	stack := make([]int, 0, g.cols())
+	var h, left, area int
+	for r := 0; r < g.rows(); r++ {
+		for c := range heights {
+			if g[r][c] == 1 {
+				heights[c]++
+			} else {
+				heights[c] = 0
+			}
+		}
+		stack = stack[:0]
+		for c := 0; c <= len(heights); c++ {
+			// a zero height sentinel at the end pops everything
+			for len(stack) > 0 && (c == len(heights) || heights[stack[len(stack)-1]] >= heights[c]) {
+				h = heights[stack[len(stack)-1]]
+				stack = stack[:len(stack)-1]
+				left = 0
+				if len(stack) > 0 {
+					left = stack[len(stack)-1] + 1
+				}
+				if area = h * (c - left); area > best {
+					best = area
+					topLeft = Cell{r - h + 1, left}
+					bottomRight = Cell{r, c - 1}
+				}
+			}
+			stack = append(stack, c)
+		}
+	}
+	return best, topLeft, bottomRight
+}
+
+// SolveMaximalRectangleTabulatedChecked finds the largest rectangle that consists of cells with value 1 only.
+// Returns ErrInvalidDimensions if the rows of the grid have different lengths.
+func SolveMaximalRectangleTabulatedChecked(g Grid) (int, Cell, Cell, error) {
+	if err := validateGrid(g); err != nil {
+		return 0, Cell{}, Cell{}, err
+	}
+	r, topLeft, bottomRight := solveMaximalRectangleTabulated(g)
+	return r, topLeft, bottomRight, nil
+}
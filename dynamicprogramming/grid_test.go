@@ -0,0 +1,311 @@
+package dynamicprogramming
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randomGrid(rows, cols, max int) Grid {
+	g := make(Grid, rows)
+	for r := range g {
+		g[r] = make([]int, cols)
+		for c := range g[r] {
+			g[r][c] = rand.Intn(max)
+		}
+	}
+	return g
+}
+
+// checkGridPath checks that path goes from top left to bottom right moving only right or down
+func checkGridPath(t *testing.T, name string, g Grid, path []Cell) {
+	if len(path) != g.rows()+g.cols()-1 || path[0] != (Cell{0, 0}) || path[len(path)-1] != (Cell{g.rows() - 1, g.cols() - 1}) {
+		t.Fatalf("%v invalid path %v", name, path)
+	}
+	for i := 1; i < len(path); i++ {
+		dr, dc := path[i].Row-path[i-1].Row, path[i].Col-path[i-1].Col
+		if !(dr == 1 && dc == 0) && !(dr == 0 && dc == 1) {
+			t.Fatalf("%v invalid path %v", name, path)
+		}
+	}
+}
+
+func TestUniquePaths(t *testing.T) {
+	tests := []struct {
+		name string
+		g    Grid
+		want string
+	}{
+		{
+			name: "3x7",
+			g:    randomGrid(3, 7, 1),
+			want: "28",
+		},
+		{
+			name: "obstacle in the middle",
+			g:    Grid{{0, 0, 0}, {0, 1, 0}, {0, 0, 0}},
+			want: "2",
+		},
+		{
+			name: "blocked start",
+			g:    Grid{{1, 0}, {0, 0}},
+			want: "0",
+		},
+		{
+			name: "empty",
+			g:    Grid{},
+			want: "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveUniquePathsRecursive(tt.g); got.String() != tt.want {
+				t.Errorf("SolveUniquePathsRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveUniquePathsTabulated(tt.g); got.String() != tt.want {
+				t.Errorf("SolveUniquePathsTabulated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// 100x100 grid without obstacles has C(198, 99) paths
+	if got := SolveUniquePathsTabulated(randomGrid(100, 100, 1)); got.String() != "22750883079422934966181954039568885395604168260154104734000" {
+		t.Errorf("SolveUniquePathsTabulated() = %v for 100x100 grid", got)
+	}
+	for i := 0; i < 20; i++ {
+		g := randomGrid(1+rand.Intn(6), 1+rand.Intn(6), 2)
+		if got, want := SolveUniquePathsTabulated(g), SolveUniquePathsRecursive(g); got.Cmp(want) != 0 {
+			t.Fatalf("SolveUniquePathsTabulated() = %v, SolveUniquePathsRecursive() = %v, grid %v", got, want, g)
+		}
+	}
+}
+
+func TestMinPathSum(t *testing.T) {
+	tests := []struct {
+		name string
+		g    Grid
+		want int
+	}{
+		{
+			name: "tc1",
+			g:    Grid{{1, 3, 1}, {1, 5, 1}, {4, 2, 1}},
+			want: 7,
+		},
+		{
+			name: "tc2",
+			g:    Grid{{1, 2, 3}, {4, 5, 6}},
+			want: 12,
+		},
+		{
+			name: "single cell",
+			g:    Grid{{5}},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveMinPathSumRecursive(tt.g); got != tt.want {
+				t.Errorf("SolveMinPathSumRecursive() = %v, want %v", got, tt.want)
+			}
+			got, path := SolveMinPathSumTabulated(tt.g)
+			if got != tt.want {
+				t.Errorf("SolveMinPathSumTabulated() = %v, want %v", got, tt.want)
+			}
+			checkGridPath(t, "SolveMinPathSumTabulated()", tt.g, path)
+			sum := 0
+			for _, c := range path {
+				sum += tt.g[c.Row][c.Col]
+			}
+			if sum != tt.want {
+				t.Errorf("SolveMinPathSumTabulated() path %v costs %v, want %v", path, sum, tt.want)
+			}
+		})
+	}
+	for i := 0; i < 20; i++ {
+		g := randomGrid(1+rand.Intn(6), 1+rand.Intn(6), 10)
+		if got, _ := SolveMinPathSumTabulated(g); got != SolveMinPathSumRecursive(g) {
+			t.Fatalf("SolveMinPathSumTabulated() = %v, SolveMinPathSumRecursive() = %v, grid %v", got, SolveMinPathSumRecursive(g), g)
+		}
+	}
+}
+
+func TestDungeon(t *testing.T) {
+	tests := []struct {
+		name string
+		g    Grid
+		want int
+	}{
+		{
+			name: "tc1",
+			g:    Grid{{-2, -3, 3}, {-5, -10, 1}, {10, 30, -5}},
+			want: 7,
+		},
+		{
+			name: "no damage",
+			g:    Grid{{0, 5}, {3, 0}},
+			want: 1,
+		},
+		{
+			name: "single cell",
+			g:    Grid{{-4}},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, path := SolveDungeonTabulated(tt.g)
+			if got != tt.want {
+				t.Errorf("SolveDungeonTabulated() = %v, want %v", got, tt.want)
+			}
+			checkGridPath(t, "SolveDungeonTabulated()", tt.g, path)
+			health := got
+			for _, c := range path {
+				health += tt.g[c.Row][c.Col]
+				if health < 1 {
+					t.Fatalf("SolveDungeonTabulated() path %v drops health below 1", path)
+				}
+			}
+		})
+	}
+}
+
+func TestMaximalSquareAndRectangle(t *testing.T) {
+	tests := []struct {
+		name      string
+		g         Grid
+		square    int
+		rectangle int
+	}{
+		{
+			name: "tc1",
+			g: Grid{
+				{1, 0, 1, 0, 0},
+				{1, 0, 1, 1, 1},
+				{1, 1, 1, 1, 1},
+				{1, 0, 0, 1, 0},
+			},
+			square:    2,
+			rectangle: 6,
+		},
+		{
+			name:      "zeros",
+			g:         Grid{{0, 0}, {0, 0}},
+			square:    0,
+			rectangle: 0,
+		},
+		{
+			name:      "ones",
+			g:         Grid{{1, 1, 1}, {1, 1, 1}},
+			square:    2,
+			rectangle: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := SolveMaximalSquareTabulated(tt.g); got != tt.square {
+				t.Errorf("SolveMaximalSquareTabulated() = %v, want %v", got, tt.square)
+			}
+			if got, _, _ := SolveMaximalRectangleTabulated(tt.g); got != tt.rectangle {
+				t.Errorf("SolveMaximalRectangleTabulated() = %v, want %v", got, tt.rectangle)
+			}
+		})
+	}
+	allOnes := func(g Grid, tl, br Cell) bool {
+		for r := tl.Row; r <= br.Row; r++ {
+			for c := tl.Col; c <= br.Col; c++ {
+				if g[r][c] != 1 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	// compare with checking every rectangle of random grids
+	for i := 0; i < 50; i++ {
+		g := randomGrid(1+rand.Intn(7), 1+rand.Intn(7), 2)
+		square, rectangle := 0, 0
+		for r1 := 0; r1 < g.rows(); r1++ {
+			for c1 := 0; c1 < g.cols(); c1++ {
+				for r2 := r1; r2 < g.rows(); r2++ {
+					for c2 := c1; c2 < g.cols(); c2++ {
+						if !allOnes(g, Cell{r1, c1}, Cell{r2, c2}) {
+							continue
+						}
+						if a := (r2 - r1 + 1) * (c2 - c1 + 1); a > rectangle {
+							rectangle = a
+						}
+						if r2-r1 == c2-c1 && r2-r1+1 > square {
+							square = r2 - r1 + 1
+						}
+					}
+				}
+			}
+		}
+		got, at := SolveMaximalSquareTabulated(g)
+		if got != square || (got > 0 && !allOnes(g, at, Cell{at.Row + got - 1, at.Col + got - 1})) {
+			t.Fatalf("SolveMaximalSquareTabulated() = %v at %v, want %v, grid %v", got, at, square, g)
+		}
+		got, tl, br := SolveMaximalRectangleTabulated(g)
+		if got != rectangle || (got > 0 && (!allOnes(g, tl, br) || (br.Row-tl.Row+1)*(br.Col-tl.Col+1) != got)) {
+			t.Fatalf("SolveMaximalRectangleTabulated() = %v at %v %v, want %v, grid %v", got, tl, br, rectangle, g)
+		}
+	}
+}
+
+func TestGridInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		g    Grid
+		want error
+	}{
+		{
+			name: "empty",
+			g:    Grid{},
+			want: nil,
+		},
+		{
+			name: "rectangular",
+			g:    Grid{{1, 1, 0}, {1, 1, 1}},
+			want: nil,
+		},
+		{
+			name: "shorter row",
+			g:    Grid{{1, 1, 0}, {1, 1}},
+			want: ErrInvalidDimensions,
+		},
+		{
+			name: "longer row",
+			g:    Grid{{1, 1}, {1, 1, 1}},
+			want: ErrInvalidDimensions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := map[string]error{}
+			_, errs["SolveUniquePathsRecursiveChecked()"] = SolveUniquePathsRecursiveChecked(tt.g)
+			_, errs["SolveUniquePathsTabulatedChecked()"] = SolveUniquePathsTabulatedChecked(tt.g)
+			_, errs["SolveMinPathSumRecursiveChecked()"] = SolveMinPathSumRecursiveChecked(tt.g)
+			_, _, errs["SolveMinPathSumTabulatedChecked()"] = SolveMinPathSumTabulatedChecked(tt.g)
+			_, _, errs["SolveDungeonTabulatedChecked()"] = SolveDungeonTabulatedChecked(tt.g)
+			_, _, errs["SolveMaximalSquareTabulatedChecked()"] = SolveMaximalSquareTabulatedChecked(tt.g)
+			_, _, _, errs["SolveMaximalRectangleTabulatedChecked()"] = SolveMaximalRectangleTabulatedChecked(tt.g)
+			for name, err := range errs {
+				if err != tt.want {
+					t.Errorf("%v error = %v, want %v", name, err, tt.want)
+				}
+			}
+			if tt.want != nil {
+				checkPanics(t, "SolveUniquePathsRecursive()", tt.want, func() { SolveUniquePathsRecursive(tt.g) })
+				checkPanics(t, "SolveUniquePathsTabulated()", tt.want, func() { SolveUniquePathsTabulated(tt.g) })
+				checkPanics(t, "SolveMinPathSumRecursive()", tt.want, func() { SolveMinPathSumRecursive(tt.g) })
+				checkPanics(t, "SolveMinPathSumTabulated()", tt.want, func() { SolveMinPathSumTabulated(tt.g) })
+				checkPanics(t, "SolveDungeonTabulated()", tt.want, func() { SolveDungeonTabulated(tt.g) })
+				checkPanics(t, "SolveMaximalSquareTabulated()", tt.want, func() { SolveMaximalSquareTabulated(tt.g) })
+				checkPanics(t, "SolveMaximalRectangleTabulated()", tt.want, func() { SolveMaximalRectangleTabulated(tt.g) })
+				return
+			}
+			want, _ := SolveMinPathSumTabulated(tt.g)
+			if got, _, _ := SolveMinPathSumTabulatedChecked(tt.g); got != want {
+				t.Errorf("SolveMinPathSumTabulatedChecked() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package dynamicprogramming
+
+import "math/big"
+
+// Problem: in how many ways can a positive integer n be written as a sum of positive integers?
+// Order of the parts does not matter, e.g. 4 = 4 = 3+1 = 2+2 = 2+1+1 = 1+1+1+1, so there are 5 partitions of 4.
+// p(n, k) is the number of partitions of n into parts that are at most k:
+// p(n, k) = p(n, k-1) + p(n-k, k), i.e. either no part is k or at least one part is k.
+
+// partitionState is the state of integer partition problem, i.e. partitions of n into parts that are at most k
+type partitionState struct {
+	n, k int
+}
+
+// SolveIntegerPartitionRecursive returns the number of partitions of n with a naive recursive approach.
+func SolveIntegerPartitionRecursive(n int) *big.Int {
+	return solveIntegerPartitionAux(n, n, nil)
+}
+
+// SolveIntegerPartitionMemoized returns the number of partitions of n by using a map for storing previously calculated values.
+func SolveIntegerPartitionMemoized(n int) *big.Int {
+	return solveIntegerPartitionAux(n, n, make(map[partitionState]*big.Int))
+}
+
+// solveIntegerPartitionAux returns p(n, k). Nothing is remembered if m is nil.
+func solveIntegerPartitionAux(n, k int, m map[partitionState]*big.Int) *big.Int {
+	if n == 0 {
+		return big.NewInt(1)
+	}
+	if n < 0 || k <= 0 {
+		return big.NewInt(0)
+	}
+	if r, ok := m[partitionState{n, k}]; ok {
+		return r
+	}
+	r := new(big.Int).Add(solveIntegerPartitionAux(n, k-1, m), solveIntegerPartitionAux(n-k, k, m))
+	if m != nil {
+		m[partitionState{n, k}] = r
+	}
+	return r
+}
+
+// SolveIntegerPartitionTabulated uses bottom up approach to find the number of partitions of n.
+// It is the coin change ways problem where coins are 1, 2, ..., n.
+func SolveIntegerPartitionTabulated(n int) *big.Int {
+	if n < 0 {
+		return big.NewInt(0)
+	}
+	coins := make([]int, n)
+	for i := range coins {
+		coins[i] = i + 1
+	}
+	return SolveCoinChangeWaysTabulated(coins, n)
+}
@@ -0,0 +1,52 @@
+package dynamicprogramming
+
+import "testing"
+
+func TestIntegerPartition(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{
+			name: "zero",
+			n:    0,
+			want: "1",
+		},
+		{
+			name: "four",
+			n:    4,
+			want: "5",
+		},
+		{
+			name: "twenty",
+			n:    20,
+			want: "627",
+		},
+		{
+			name: "hundred",
+			n:    100,
+			want: "190569292",
+		},
+		{
+			name: "thousand",
+			n:    1000,
+			want: "24061467864032622473692149727991",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.n <= 20 {
+				if got := SolveIntegerPartitionRecursive(tt.n); got.String() != tt.want {
+					t.Errorf("SolveIntegerPartitionRecursive() = %v, want %v", got, tt.want)
+				}
+			}
+			if got := SolveIntegerPartitionMemoized(tt.n); got.String() != tt.want {
+				t.Errorf("SolveIntegerPartitionMemoized() = %v, want %v", got, tt.want)
+			}
+			if got := SolveIntegerPartitionTabulated(tt.n); got.String() != tt.want {
+				t.Errorf("SolveIntegerPartitionTabulated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package dynamicprogramming
+
+// Interval dynamic programming.
+// Many problems are solved on intervals [i, j] by trying every split point k in between,
+// solving [i, k] and [k, j] independently and combining the results.
+// Matrix chain multiplication, max number with signs, polygon triangulation and optimal binary search trees
+// are all of this form:
+// c(i, i+1) = base(i)
+// c(i, j) = best over i < k < j of combine(i, k, j, c(i, k), c(k, j))
+// Note that neighbouring intervals share their boundary k.
+
+// IntervalProblem describes an interval dynamic programming problem on boundaries 0 to N.
+type IntervalProblem struct {
+	N        int                                // intervals are [i, j] where 0 <= i < j <= N
+	Base     func(i int) int                    // value of the unit interval [i, i+1]
+	Combine  func(i, k, j, left, right int) int // value of [i, j] when split at k, left and right are values of [i, k] and [k, j]
+	Maximize bool                               // the best value is the maximum if true and the minimum otherwise
+	// Knuth restricts split points of [i, j] to the range Split[i][j-1] to Split[i+1][j], which makes the engine O(n^2).
+	// It should only be set for problems whose best split points are monotone, e.g. optimal binary search trees.
+	Knuth bool
+	// Trace is called whenever the value of an interval [i, j] is set or improves, as a step of row i and column j.
+	Trace TraceFunc
+}
+
+// IntervalSolution holds the tables that are filled by SolveIntervalProblem.
+type IntervalSolution struct {
+	Value [][]int // Value[i][j] is the best value of interval [i, j]
+	Split [][]int // Split[i][j] is the split point of the best value of interval [i, j], -1 for unit intervals
+}
+
+// SolveIntervalProblem uses bottom up approach to solve an interval problem, i.e. intervals are solved in increasing length.
+func SolveIntervalProblem(p IntervalProblem) *IntervalSolution {
+	s := &IntervalSolution{Value: make([][]int, p.N+1)}
+	for i := range s.Value {
+		s.Value[i] = make([]int, p.N+1)
+	}
+	s.Split = solveIntervals(p.N, p.Knuth, func(i int) {
+		s.Value[i][i+1] = p.Base(i)
+		p.Trace.emit(TraceStep{Row: i, Col: i + 1, Value: s.Value[i][i+1], Split: -1})
+	}, func(i, k, j int, first bool) bool {
+		v := p.Combine(i, k, j, s.Value[i][k], s.Value[k][j])
+		if first || (p.Maximize && v > s.Value[i][j]) || (!p.Maximize && v < s.Value[i][j]) {
+			s.Value[i][j] = v
+			p.Trace.emit(TraceStep{Row: i, Col: j, Value: v, Split: k})
+			return true
+		}
+		return false
+	})
+	return s
+}
+
+// solveIntervals visits the intervals on boundaries 0 to n in increasing length and returns the split table,
+// values are kept by the caller so that the same engine works for any type of value.
+// base sets the value of the unit interval [i, i+1]. split tries splitting [i, j] at k, sets its value if it is
+// the first split point that is tried or better than the current value and returns true if it did.
+// knuth restricts split points the same way as IntervalProblem.Knuth.
+func solveIntervals(n int, knuth bool, base func(i int), split func(i, k, j int, first bool) bool) [][]int {
+	s := make([][]int, n+1)
+	for i := range s {
+		s[i] = make([]int, n+1)
+	}
+	var j, lo, hi int
+	for length := 1; length <= n; length++ {
+		for i := 0; i+length <= n; i++ {
+			j = i + length
+			if length == 1 {
+				base(i)
+				s[i][j] = -1
+				continue
+			}
+			lo, hi = i+1, j-1
+			if knuth && length > 2 {
+				lo, hi = s[i][j-1], s[i+1][j]
+			}
+			for k := lo; k <= hi; k++ {
+				if split(i, k, j, k == lo) {
+					s[i][j] = k
+				}
+			}
+		}
+	}
+	return s
+}
+
+// Best returns the best value for the whole range [0, N]
+func (s *IntervalSolution) Best() int {
+	return s.Value[0][len(s.Value)-1]
+}
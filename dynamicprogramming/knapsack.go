@@ -0,0 +1,543 @@
+package dynamicprogramming
+
+// Introduction to Algorithms 3rd Edition, Exercise 16.2-2
+
+// Problem: a thief robbing a store finds n items. i'th item is worth Value dollars and weighs Weight pounds.
+// The thief wants to take as valuable a load as possible, but he can carry at most W pounds in his knapsack.
+// Which items should he take?
+// 0/1 knapsack: each item is either taken or left behind.
+// unbounded knapsack: each item can be taken any number of times.
+// bounded knapsack: i'th item can be taken at most Count times.
+// two-constraint knapsack: each item also has a Volume and the knapsack can hold at most V units of volume.
+
+// KnapsackItem is an item that can be put into a knapsack.
+// Volume is only used by two-constraint knapsack solvers and Count is only used by bounded knapsack solvers.
+// Weight should be positive and the other fields, capacity and volume should not be negative. Otherwise the Checked solvers
+// return ErrNonPositiveInput or ErrNegativeInput and the other solvers panic with the same error.
+type KnapsackItem struct {
+	Weight, Volume, Value, Count int
+}
+
+// validateKnapsack checks that capacity, volume and the fields of the items are not negative and the weights are positive
+func validateKnapsack(items []KnapsackItem, capacity, volume int) error {
+	if capacity < 0 || volume < 0 {
+		return ErrNegativeInput
+	}
+	for _, item := range items {
+		if item.Weight <= 0 {
+			return ErrNonPositiveInput
+		}
+		if item.Volume < 0 || item.Value < 0 || item.Count < 0 {
+			return ErrNegativeInput
+		}
+	}
+	return nil
+}
+
+// SolveKnapsack01Recursive solves 0/1 knapsack problem with a naive recursive approach.
+func SolveKnapsack01Recursive(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsack01RecursiveChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsack01RecursiveChecked solves 0/1 knapsack problem with a naive recursive approach.
+func SolveKnapsack01RecursiveChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	return solveKnapsack01Recursive(items, capacity), nil
+}
+
+func solveKnapsack01Recursive(items []KnapsackItem, capacity int) int {
+	if len(items) == 0 || capacity <= 0 {
+		return 0
+	}
+	last := items[len(items)-1]
+	q := solveKnapsack01Recursive(items[:len(items)-1], capacity) // leave the last item
+	if last.Weight <= capacity {
+		r := last.Value + solveKnapsack01Recursive(items[:len(items)-1], capacity-last.Weight) // take the last item
+		if r > q {
+			q = r
+		}
+	}
+	return q
+}
+
+// SolveKnapsack01Memoized solves 0/1 knapsack problem with a top down approach
+// by using a slice of slices for storing previously calculated values.
+// m[i][c] holds the best value for first i items and capacity c, -1 means not calculated yet.
+func SolveKnapsack01Memoized(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsack01MemoizedChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsack01MemoizedChecked solves 0/1 knapsack problem with a top down approach.
+func SolveKnapsack01MemoizedChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	if len(items) == 0 || capacity <= 0 {
+		return 0, nil
+	}
+	m := make([][]int, len(items)+1)
+	for i := range m {
+		m[i] = make([]int, capacity+1)
+		for c := range m[i] {
+			m[i][c] = -1
+		}
+	}
+	return solveKnapsack01MemoizedAux(items, len(items), capacity, m), nil
+}
+
+func solveKnapsack01MemoizedAux(items []KnapsackItem, i, capacity int, m [][]int) int {
+	if i == 0 || capacity <= 0 {
+		return 0
+	}
+	if m[i][capacity] >= 0 {
+		return m[i][capacity]
+	}
+	q := solveKnapsack01MemoizedAux(items, i-1, capacity, m)
+	if items[i-1].Weight <= capacity {
+		r := items[i-1].Value + solveKnapsack01MemoizedAux(items, i-1, capacity-items[i-1].Weight, m)
+		if r > q {
+			q = r
+		}
+	}
+	m[i][capacity] = q
+	return q
+}
+
+// SolveKnapsack01Tabulated solves 0/1 knapsack problem with a bottom up approach.
+// Returns the maximum value and the selection, where selection[i] is 1 if items[i] is taken and 0 otherwise.
+func SolveKnapsack01Tabulated(items []KnapsackItem, capacity int) (int, []int) {
+	r, selection, err := SolveKnapsack01TabulatedChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r, selection
+}
+
+// SolveKnapsack01TabulatedChecked solves 0/1 knapsack problem with a bottom up approach.
+func SolveKnapsack01TabulatedChecked(items []KnapsackItem, capacity int) (int, []int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, nil, err
+	}
+	selection := make([]int, len(items))
+	if capacity <= 0 {
+		return 0, selection, nil
+	}
+	t := make([][]int, len(items)+1)
+	for i := range t {
+		t[i] = make([]int, capacity+1)
+	}
+	var v int
+	for i := 1; i <= len(items); i++ {
+		for c := 0; c <= capacity; c++ {
+			t[i][c] = t[i-1][c]
+			if items[i-1].Weight <= c {
+				v = items[i-1].Value + t[i-1][c-items[i-1].Weight]
+				if v > t[i][c] {
+					t[i][c] = v
+				}
+			}
+		}
+	}
+	// walk back through the table, an item is taken if it changed the best value
+	c := capacity
+	for i := len(items); i > 0; i-- {
+		if t[i][c] != t[i-1][c] {
+			selection[i-1] = 1
+			c -= items[i-1].Weight
+		}
+	}
+	return t[len(items)][capacity], selection, nil
+}
+
+// SolveKnapsack01DPSO solves 0/1 knapsack problem by using a single row instead of the whole table.
+// Capacities are iterated in decreasing order so that each item is used at most once.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveKnapsack01DPSO(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsack01DPSOChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsack01DPSOChecked solves 0/1 knapsack problem by using a single row instead of the whole table.
+func SolveKnapsack01DPSOChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	if capacity <= 0 {
+		return 0, nil
+	}
+	r := make([]int, capacity+1)
+	var v int
+	for _, item := range items {
+		for c := capacity; c >= item.Weight; c-- {
+			v = item.Value + r[c-item.Weight]
+			if v > r[c] {
+				r[c] = v
+			}
+		}
+	}
+	return r[capacity], nil
+}
+
+// SolveKnapsackUnboundedRecursive solves unbounded knapsack problem with a naive recursive approach.
+func SolveKnapsackUnboundedRecursive(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsackUnboundedRecursiveChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsackUnboundedRecursiveChecked solves unbounded knapsack problem with a naive recursive approach.
+func SolveKnapsackUnboundedRecursiveChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	return solveKnapsackUnboundedRecursive(items, capacity), nil
+}
+
+func solveKnapsackUnboundedRecursive(items []KnapsackItem, capacity int) int {
+	if capacity <= 0 {
+		return 0
+	}
+	var q, r int
+	for _, item := range items {
+		if item.Weight <= capacity {
+			r = item.Value + solveKnapsackUnboundedRecursive(items, capacity-item.Weight)
+			if r > q {
+				q = r
+			}
+		}
+	}
+	return q
+}
+
+// SolveKnapsackUnboundedTabulated solves unbounded knapsack problem with a bottom up approach.
+// Returns the maximum value and the selection, where selection[i] is the number of times items[i] is taken.
+func SolveKnapsackUnboundedTabulated(items []KnapsackItem, capacity int) (int, []int) {
+	r, selection, err := SolveKnapsackUnboundedTabulatedChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r, selection
+}
+
+// SolveKnapsackUnboundedTabulatedChecked solves unbounded knapsack problem with a bottom up approach.
+func SolveKnapsackUnboundedTabulatedChecked(items []KnapsackItem, capacity int) (int, []int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, nil, err
+	}
+	selection := make([]int, len(items))
+	if capacity <= 0 {
+		return 0, selection, nil
+	}
+	r := make([]int, capacity+1)
+	// choice[c] is the index of the item that was last added for capacity c, -1 if none
+	choice := make([]int, capacity+1)
+	choice[0] = -1
+	var v int
+	for c := 1; c <= capacity; c++ {
+		r[c] = r[c-1]
+		choice[c] = -1
+		for i, item := range items {
+			if item.Weight <= c {
+				v = item.Value + r[c-item.Weight]
+				if v > r[c] {
+					r[c] = v
+					choice[c] = i
+				}
+			}
+		}
+	}
+	c := capacity
+	for c > 0 {
+		if choice[c] == -1 {
+			c--
+			continue
+		}
+		selection[choice[c]]++
+		c -= items[choice[c]].Weight
+	}
+	return r[capacity], selection, nil
+}
+
+// SolveKnapsackUnboundedDPSO solves unbounded knapsack problem by using a single row and no selection table.
+// Capacities are iterated in increasing order so that each item can be used again.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveKnapsackUnboundedDPSO(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsackUnboundedDPSOChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsackUnboundedDPSOChecked solves unbounded knapsack problem by using a single row and no selection table.
+func SolveKnapsackUnboundedDPSOChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	if capacity <= 0 {
+		return 0, nil
+	}
+	r := make([]int, capacity+1)
+	var v int
+	for _, item := range items {
+		for c := item.Weight; c <= capacity; c++ {
+			v = item.Value + r[c-item.Weight]
+			if v > r[c] {
+				r[c] = v
+			}
+		}
+	}
+	return r[capacity], nil
+}
+
+// SolveKnapsackBoundedRecursive solves bounded knapsack problem with a naive recursive approach.
+func SolveKnapsackBoundedRecursive(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsackBoundedRecursiveChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsackBoundedRecursiveChecked solves bounded knapsack problem with a naive recursive approach.
+func SolveKnapsackBoundedRecursiveChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	return solveKnapsackBoundedRecursive(items, capacity), nil
+}
+
+func solveKnapsackBoundedRecursive(items []KnapsackItem, capacity int) int {
+	if len(items) == 0 || capacity <= 0 {
+		return 0
+	}
+	last := items[len(items)-1]
+	var q, r int
+	for k := 0; k <= last.Count && k*last.Weight <= capacity; k++ {
+		r = k*last.Value + solveKnapsackBoundedRecursive(items[:len(items)-1], capacity-k*last.Weight)
+		if r > q {
+			q = r
+		}
+	}
+	return q
+}
+
+// SolveKnapsackBoundedTabulated solves bounded knapsack problem with a bottom up approach.
+// Returns the maximum value and the selection, where selection[i] is the number of times items[i] is taken.
+func SolveKnapsackBoundedTabulated(items []KnapsackItem, capacity int) (int, []int) {
+	r, selection, err := SolveKnapsackBoundedTabulatedChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r, selection
+}
+
+// SolveKnapsackBoundedTabulatedChecked solves bounded knapsack problem with a bottom up approach.
+func SolveKnapsackBoundedTabulatedChecked(items []KnapsackItem, capacity int) (int, []int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, nil, err
+	}
+	selection := make([]int, len(items))
+	if capacity <= 0 {
+		return 0, selection, nil
+	}
+	t := make([][]int, len(items)+1)
+	// taken[i][c] is the number of copies of items[i-1] used for t[i][c]
+	taken := make([][]int, len(items)+1)
+	for i := range t {
+		t[i] = make([]int, capacity+1)
+		taken[i] = make([]int, capacity+1)
+	}
+	var v int
+	for i := 1; i <= len(items); i++ {
+		item := items[i-1]
+		for c := 0; c <= capacity; c++ {
+			t[i][c] = t[i-1][c]
+			for k := 1; k <= item.Count && k*item.Weight <= c; k++ {
+				v = k*item.Value + t[i-1][c-k*item.Weight]
+				if v > t[i][c] {
+					t[i][c] = v
+					taken[i][c] = k
+				}
+			}
+		}
+	}
+	c := capacity
+	for i := len(items); i > 0; i-- {
+		selection[i-1] = taken[i][c]
+		c -= taken[i][c] * items[i-1].Weight
+	}
+	return t[len(items)][capacity], selection, nil
+}
+
+// SolveKnapsackBoundedDPSO solves bounded knapsack problem by using a single row instead of the whole table.
+// Capacities are iterated in decreasing order so that r[c-k*Weight] still holds the value without the current item.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveKnapsackBoundedDPSO(items []KnapsackItem, capacity int) int {
+	r, err := SolveKnapsackBoundedDPSOChecked(items, capacity)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsackBoundedDPSOChecked solves bounded knapsack problem by using a single row instead of the whole table.
+func SolveKnapsackBoundedDPSOChecked(items []KnapsackItem, capacity int) (int, error) {
+	if err := validateKnapsack(items, capacity, 0); err != nil {
+		return 0, err
+	}
+	if capacity <= 0 {
+		return 0, nil
+	}
+	r := make([]int, capacity+1)
+	var v int
+	for _, item := range items {
+		for c := capacity; c >= item.Weight; c-- {
+			for k := 1; k <= item.Count && k*item.Weight <= c; k++ {
+				v = k*item.Value + r[c-k*item.Weight]
+				if v > r[c] {
+					r[c] = v
+				}
+			}
+		}
+	}
+	return r[capacity], nil
+}
+
+// SolveKnapsack2DRecursive solves two-constraint 0/1 knapsack problem with a naive recursive approach.
+// capacity limits the total Weight and volume limits the total Volume of the taken items.
+func SolveKnapsack2DRecursive(items []KnapsackItem, capacity, volume int) int {
+	r, err := SolveKnapsack2DRecursiveChecked(items, capacity, volume)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsack2DRecursiveChecked solves two-constraint 0/1 knapsack problem with a naive recursive approach.
+func SolveKnapsack2DRecursiveChecked(items []KnapsackItem, capacity, volume int) (int, error) {
+	if err := validateKnapsack(items, capacity, volume); err != nil {
+		return 0, err
+	}
+	return solveKnapsack2DRecursive(items, capacity, volume), nil
+}
+
+func solveKnapsack2DRecursive(items []KnapsackItem, capacity, volume int) int {
+	if len(items) == 0 || capacity <= 0 || volume <= 0 {
+		return 0
+	}
+	last := items[len(items)-1]
+	q := solveKnapsack2DRecursive(items[:len(items)-1], capacity, volume)
+	if last.Weight <= capacity && last.Volume <= volume {
+		r := last.Value + solveKnapsack2DRecursive(items[:len(items)-1], capacity-last.Weight, volume-last.Volume)
+		if r > q {
+			q = r
+		}
+	}
+	return q
+}
+
+// SolveKnapsack2DTabulated solves two-constraint 0/1 knapsack problem with a bottom up approach.
+// capacity limits the total Weight and volume limits the total Volume of the taken items.
+// Returns the maximum value and the selection, where selection[i] is 1 if items[i] is taken and 0 otherwise.
+func SolveKnapsack2DTabulated(items []KnapsackItem, capacity, volume int) (int, []int) {
+	r, selection, err := SolveKnapsack2DTabulatedChecked(items, capacity, volume)
+	if err != nil {
+		panic(err)
+	}
+	return r, selection
+}
+
+// SolveKnapsack2DTabulatedChecked solves two-constraint 0/1 knapsack problem with a bottom up approach.
+func SolveKnapsack2DTabulatedChecked(items []KnapsackItem, capacity, volume int) (int, []int, error) {
+	if err := validateKnapsack(items, capacity, volume); err != nil {
+		return 0, nil, err
+	}
+	selection := make([]int, len(items))
+	if capacity <= 0 || volume <= 0 {
+		return 0, selection, nil
+	}
+	t := make([][][]int, len(items)+1)
+	for i := range t {
+		t[i] = make([][]int, capacity+1)
+		for c := range t[i] {
+			t[i][c] = make([]int, volume+1)
+		}
+	}
+	var v int
+	for i := 1; i <= len(items); i++ {
+		item := items[i-1]
+		for c := 0; c <= capacity; c++ {
+			for u := 0; u <= volume; u++ {
+				t[i][c][u] = t[i-1][c][u]
+				if item.Weight <= c && item.Volume <= u {
+					v = item.Value + t[i-1][c-item.Weight][u-item.Volume]
+					if v > t[i][c][u] {
+						t[i][c][u] = v
+					}
+				}
+			}
+		}
+	}
+	c, u := capacity, volume
+	for i := len(items); i > 0; i-- {
+		if t[i][c][u] != t[i-1][c][u] {
+			selection[i-1] = 1
+			c -= items[i-1].Weight
+			u -= items[i-1].Volume
+		}
+	}
+	return t[len(items)][capacity][volume], selection, nil
+}
+
+// SolveKnapsack2DDPSO solves two-constraint 0/1 knapsack problem by using a single capacity x volume layer
+// instead of one layer per item. Both dimensions are iterated in decreasing order so that each item is used at most once.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveKnapsack2DDPSO(items []KnapsackItem, capacity, volume int) int {
+	r, err := SolveKnapsack2DDPSOChecked(items, capacity, volume)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveKnapsack2DDPSOChecked solves two-constraint 0/1 knapsack problem by using a single capacity x volume layer.
+func SolveKnapsack2DDPSOChecked(items []KnapsackItem, capacity, volume int) (int, error) {
+	if err := validateKnapsack(items, capacity, volume); err != nil {
+		return 0, err
+	}
+	if capacity <= 0 || volume <= 0 {
+		return 0, nil
+	}
+	r := make([][]int, capacity+1)
+	for c := range r {
+		r[c] = make([]int, volume+1)
+	}
+	var v int
+	for _, item := range items {
+		for c := capacity; c >= item.Weight; c-- {
+			for u := volume; u >= item.Volume; u-- {
+				v = item.Value + r[c-item.Weight][u-item.Volume]
+				if v > r[c][u] {
+					r[c][u] = v
+				}
+			}
+		}
+	}
+	return r[capacity][volume], nil
+}
@@ -0,0 +1,353 @@
+package dynamicprogramming
+
+import "testing"
+
+var knapsackTestItems = []KnapsackItem{
+	{Weight: 10, Volume: 5, Value: 60, Count: 2},
+	{Weight: 20, Volume: 1, Value: 100, Count: 1},
+	{Weight: 30, Volume: 4, Value: 120, Count: 3},
+}
+
+// checkKnapsackSelection checks that the selection fits into the knapsack and adds up to the expected value
+func checkKnapsackSelection(t *testing.T, name string, items []KnapsackItem, selection []int, capacity, volume, want int) {
+	if len(selection) != len(items) {
+		t.Fatalf("%v selection length = %v, want %v", name, len(selection), len(items))
+	}
+	var w, u, v int
+	for i, k := range selection {
+		w += k * items[i].Weight
+		u += k * items[i].Volume
+		v += k * items[i].Value
+	}
+	if w > capacity || (volume >= 0 && u > volume) {
+		t.Errorf("%v selection %v does not fit, weight %v, volume %v", name, selection, w, u)
+	}
+	if v != want {
+		t.Errorf("%v selection %v is worth %v, want %v", name, selection, v, want)
+	}
+}
+
+func TestKnapsack01(t *testing.T) {
+	type args struct {
+		items    []KnapsackItem
+		capacity int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "clrs",
+			args: args{knapsackTestItems, 50},
+			want: 220,
+		},
+		{
+			name: "zero capacity",
+			args: args{knapsackTestItems, 0},
+			want: 0,
+		},
+		{
+			name: "nothing fits",
+			args: args{knapsackTestItems, 9},
+			want: 0,
+		},
+		{
+			name: "everything fits",
+			args: args{knapsackTestItems, 100},
+			want: 280,
+		},
+		{
+			name: "no items",
+			args: args{nil, 10},
+			want: 0,
+		},
+		{
+			name: "tc1",
+			args: args{
+				[]KnapsackItem{{Weight: 1, Value: 1}, {Weight: 3, Value: 4}, {Weight: 4, Value: 5}, {Weight: 5, Value: 7}},
+				7,
+			},
+			want: 9,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveKnapsack01Recursive(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsack01Recursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveKnapsack01Memoized(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsack01Memoized() = %v, want %v", got, tt.want)
+			}
+			if got := SolveKnapsack01DPSO(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsack01DPSO() = %v, want %v", got, tt.want)
+			}
+			got, selection := SolveKnapsack01Tabulated(tt.args.items, tt.args.capacity)
+			if got != tt.want {
+				t.Errorf("SolveKnapsack01Tabulated() = %v, want %v", got, tt.want)
+			}
+			checkKnapsackSelection(t, "SolveKnapsack01Tabulated()", tt.args.items, selection, tt.args.capacity, -1, tt.want)
+			for _, k := range selection {
+				if k > 1 {
+					t.Errorf("SolveKnapsack01Tabulated() selection %v takes an item more than once", selection)
+				}
+			}
+		})
+	}
+}
+
+func TestKnapsackUnbounded(t *testing.T) {
+	type args struct {
+		items    []KnapsackItem
+		capacity int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "clrs",
+			args: args{knapsackTestItems, 50},
+			want: 300,
+		},
+		{
+			name: "nothing fits",
+			args: args{knapsackTestItems, 9},
+			want: 0,
+		},
+		{
+			name: "rod cutting",
+			args: args{
+				[]KnapsackItem{
+					{Weight: 1, Value: 1}, {Weight: 2, Value: 5}, {Weight: 3, Value: 8}, {Weight: 4, Value: 9}, {Weight: 5, Value: 10},
+					{Weight: 6, Value: 17}, {Weight: 7, Value: 17}, {Weight: 8, Value: 20}, {Weight: 9, Value: 24}, {Weight: 10, Value: 30},
+				},
+				7,
+			},
+			want: 18,
+		},
+		{
+			name: "tc1",
+			args: args{
+				[]KnapsackItem{{Weight: 5, Value: 10}, {Weight: 10, Value: 30}, {Weight: 15, Value: 20}},
+				100,
+			},
+			want: 300,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveKnapsackUnboundedRecursive(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsackUnboundedRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveKnapsackUnboundedDPSO(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsackUnboundedDPSO() = %v, want %v", got, tt.want)
+			}
+			got, selection := SolveKnapsackUnboundedTabulated(tt.args.items, tt.args.capacity)
+			if got != tt.want {
+				t.Errorf("SolveKnapsackUnboundedTabulated() = %v, want %v", got, tt.want)
+			}
+			checkKnapsackSelection(t, "SolveKnapsackUnboundedTabulated()", tt.args.items, selection, tt.args.capacity, -1, tt.want)
+		})
+	}
+}
+
+func TestKnapsackBounded(t *testing.T) {
+	type args struct {
+		items    []KnapsackItem
+		capacity int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "clrs",
+			args: args{knapsackTestItems, 50},
+			want: 240,
+		},
+		{
+			name: "everything fits",
+			args: args{knapsackTestItems, 1000},
+			want: 580,
+		},
+		{
+			name: "zero counts",
+			args: args{
+				[]KnapsackItem{{Weight: 1, Value: 100, Count: 0}, {Weight: 2, Value: 3, Count: 2}},
+				10,
+			},
+			want: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveKnapsackBoundedRecursive(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsackBoundedRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveKnapsackBoundedDPSO(tt.args.items, tt.args.capacity); got != tt.want {
+				t.Errorf("SolveKnapsackBoundedDPSO() = %v, want %v", got, tt.want)
+			}
+			got, selection := SolveKnapsackBoundedTabulated(tt.args.items, tt.args.capacity)
+			if got != tt.want {
+				t.Errorf("SolveKnapsackBoundedTabulated() = %v, want %v", got, tt.want)
+			}
+			checkKnapsackSelection(t, "SolveKnapsackBoundedTabulated()", tt.args.items, selection, tt.args.capacity, -1, tt.want)
+			for i, k := range selection {
+				if k > tt.args.items[i].Count {
+					t.Errorf("SolveKnapsackBoundedTabulated() selection %v exceeds item counts", selection)
+				}
+			}
+		})
+	}
+}
+
+func TestKnapsack2D(t *testing.T) {
+	type args struct {
+		items            []KnapsackItem
+		capacity, volume int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "weight bound",
+			args: args{knapsackTestItems, 50, 100},
+			want: 220,
+		},
+		{
+			name: "volume bound",
+			args: args{knapsackTestItems, 100, 4},
+			want: 120,
+		},
+		{
+			name: "both bound",
+			args: args{knapsackTestItems, 40, 9},
+			want: 180,
+		},
+		{
+			name: "zero volume",
+			args: args{knapsackTestItems, 100, 0},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveKnapsack2DRecursive(tt.args.items, tt.args.capacity, tt.args.volume); got != tt.want {
+				t.Errorf("SolveKnapsack2DRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveKnapsack2DDPSO(tt.args.items, tt.args.capacity, tt.args.volume); got != tt.want {
+				t.Errorf("SolveKnapsack2DDPSO() = %v, want %v", got, tt.want)
+			}
+			got, selection := SolveKnapsack2DTabulated(tt.args.items, tt.args.capacity, tt.args.volume)
+			if got != tt.want {
+				t.Errorf("SolveKnapsack2DTabulated() = %v, want %v", got, tt.want)
+			}
+			checkKnapsackSelection(t, "SolveKnapsack2DTabulated()", tt.args.items, selection, tt.args.capacity, tt.args.volume, tt.want)
+		})
+	}
+}
+
+func BenchmarkKnapsack(b *testing.B) {
+	items := make([]KnapsackItem, 100)
+	for i := range items {
+		items[i] = KnapsackItem{Weight: 1 + i%17, Volume: 1 + i%7, Value: 1 + i*i%31, Count: 1 + i%3}
+	}
+	capacity, volume := 1000, 50
+	benchmarks := []struct {
+		name  string
+		solve func()
+	}{
+		{"01/Tabulated", func() { SolveKnapsack01Tabulated(items, capacity) }},
+		{"01/DPSO", func() { SolveKnapsack01DPSO(items, capacity) }},
+		{"Unbounded/Tabulated", func() { SolveKnapsackUnboundedTabulated(items, capacity) }},
+		{"Unbounded/DPSO", func() { SolveKnapsackUnboundedDPSO(items, capacity) }},
+		{"Bounded/Tabulated", func() { SolveKnapsackBoundedTabulated(items, capacity) }},
+		{"Bounded/DPSO", func() { SolveKnapsackBoundedDPSO(items, capacity) }},
+		{"2D/Tabulated", func() { SolveKnapsack2DTabulated(items, capacity, volume) }},
+		{"2D/DPSO", func() { SolveKnapsack2DDPSO(items, capacity, volume) }},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bm.solve()
+			}
+		})
+	}
+}
+
+func TestKnapsackInvalid(t *testing.T) {
+	type args struct {
+		items            []KnapsackItem
+		capacity, volume int
+	}
+	tests := []struct {
+		name string
+		args args
+		want error
+	}{
+		{
+			name: "negative capacity",
+			args: args{knapsackTestItems, -1, 10},
+			want: ErrNegativeInput,
+		},
+		{
+			name: "zero weight",
+			args: args{[]KnapsackItem{{Weight: 0, Value: 1, Count: 1}}, 10, 10},
+			want: ErrNonPositiveInput,
+		},
+		{
+			name: "negative weight",
+			args: args{[]KnapsackItem{{Weight: -2, Value: 1, Count: 1}}, 10, 10},
+			want: ErrNonPositiveInput,
+		},
+		{
+			name: "negative value",
+			args: args{[]KnapsackItem{{Weight: 2, Value: -1, Count: 1}}, 10, 10},
+			want: ErrNegativeInput,
+		},
+		{
+			name: "negative count",
+			args: args{[]KnapsackItem{{Weight: 2, Value: 1, Count: -1}}, 10, 10},
+			want: ErrNegativeInput,
+		},
+		{
+			name: "negative item volume",
+			args: args{[]KnapsackItem{{Weight: 2, Volume: -1, Value: 1, Count: 1}}, 10, 10},
+			want: ErrNegativeInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, capacity := tt.args.items, tt.args.capacity
+			errs := map[string]error{}
+			_, errs["SolveKnapsack01RecursiveChecked()"] = SolveKnapsack01RecursiveChecked(items, capacity)
+			_, errs["SolveKnapsack01MemoizedChecked()"] = SolveKnapsack01MemoizedChecked(items, capacity)
+			_, _, errs["SolveKnapsack01TabulatedChecked()"] = SolveKnapsack01TabulatedChecked(items, capacity)
+			_, errs["SolveKnapsack01DPSOChecked()"] = SolveKnapsack01DPSOChecked(items, capacity)
+			_, errs["SolveKnapsackUnboundedRecursiveChecked()"] = SolveKnapsackUnboundedRecursiveChecked(items, capacity)
+			_, _, errs["SolveKnapsackUnboundedTabulatedChecked()"] = SolveKnapsackUnboundedTabulatedChecked(items, capacity)
+			_, errs["SolveKnapsackUnboundedDPSOChecked()"] = SolveKnapsackUnboundedDPSOChecked(items, capacity)
+			_, errs["SolveKnapsackBoundedRecursiveChecked()"] = SolveKnapsackBoundedRecursiveChecked(items, capacity)
+			_, _, errs["SolveKnapsackBoundedTabulatedChecked()"] = SolveKnapsackBoundedTabulatedChecked(items, capacity)
+			_, errs["SolveKnapsackBoundedDPSOChecked()"] = SolveKnapsackBoundedDPSOChecked(items, capacity)
+			_, errs["SolveKnapsack2DRecursiveChecked()"] = SolveKnapsack2DRecursiveChecked(items, capacity, tt.args.volume)
+			_, _, errs["SolveKnapsack2DTabulatedChecked()"] = SolveKnapsack2DTabulatedChecked(items, capacity, tt.args.volume)
+			_, errs["SolveKnapsack2DDPSOChecked()"] = SolveKnapsack2DDPSOChecked(items, capacity, tt.args.volume)
+			for name, err := range errs {
+				if err != tt.want {
+					t.Errorf("%v error = %v, want %v", name, err, tt.want)
+				}
+			}
+			checkPanics(t, "SolveKnapsackUnboundedRecursive()", tt.want, func() { SolveKnapsackUnboundedRecursive(items, capacity) })
+		})
+	}
+	if _, err := SolveKnapsack2DDPSOChecked(knapsackTestItems, 10, -1); err != ErrNegativeInput {
+		t.Errorf("SolveKnapsack2DDPSOChecked() error = %v, want %v for a negative volume", err, ErrNegativeInput)
+	}
+}
@@ -0,0 +1,168 @@
+package dynamicprogramming
+
+// Introduction to Algorithms 3rd Edition, Section 15.4
+
+// Problem: given two sequences a and b, find a maximum length common subsequence of a and b.
+// A subsequence is the given sequence with zero or more elements left out.
+
+// SolveLCSRecursive returns the length of the longest common subsequence of a and b with a naive recursive approach.
+func SolveLCSRecursive(a, b []byte) int {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	if a[len(a)-1] == b[len(b)-1] {
+		return SolveLCSRecursive(a[:len(a)-1], b[:len(b)-1]) + 1
+	}
+	l := SolveLCSRecursive(a[:len(a)-1], b)
+	r := SolveLCSRecursive(a, b[:len(b)-1])
+	if l > r {
+		return l
+	}
+	return r
+}
+
+// SolveLCSMemoized returns the length of the longest common subsequence of a and b
+// by using a slice of slices for storing previously calculated values.
+// m[i][j] holds the length for a[:i] and b[:j], -1 means not calculated yet.
+func SolveLCSMemoized(a, b []byte) int {
+	m := make([][]int, len(a)+1)
+	for i := range m {
+		m[i] = make([]int, len(b)+1)
+		for j := range m[i] {
+			m[i][j] = -1
+		}
+	}
+	return solveLCSMemoizedAux(a, b, len(a), len(b), m)
+}
+
+func solveLCSMemoizedAux(a, b []byte, i, j int, m [][]int) int {
+	if i == 0 || j == 0 {
+		return 0
+	}
+	if m[i][j] >= 0 {
+		return m[i][j]
+	}
+	var r int
+	if a[i-1] == b[j-1] {
+		r = solveLCSMemoizedAux(a, b, i-1, j-1, m) + 1
+	} else {
+		r = solveLCSMemoizedAux(a, b, i-1, j, m)
+		if l := solveLCSMemoizedAux(a, b, i, j-1, m); l > r {
+			r = l
+		}
+	}
+	m[i][j] = r
+	return r
+}
+
+// SolveLCSTabulated uses bottom up approach to find the longest common subsequence of a and b.
+// Returns the length and one of the longest common subsequences.
+func SolveLCSTabulated(a, b []byte) (int, []byte) {
+	c := lcsTable(a, b)
+	n := c[len(a)][len(b)]
+	s := make([]byte, n)
+	i, j, k := len(a), len(b), n
+	for i > 0 && j > 0 {
+		if a[i-1] == b[j-1] {
+			k--
+			s[k] = a[i-1]
+			i--
+			j--
+		} else if c[i-1][j] >= c[i][j-1] {
+			i--
+		} else {
+			j--
+		}
+	}
+	return n, s
+}
+
+// lcsTable returns the table c where c[i][j] is the length of the longest common subsequence of a[:i] and b[:j]
+func lcsTable(a, b []byte) [][]int {
+	c := make([][]int, len(a)+1)
+	for i := range c {
+		c[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				c[i][j] = c[i-1][j-1] + 1
+			} else if c[i-1][j] >= c[i][j-1] {
+				c[i][j] = c[i-1][j]
+			} else {
+				c[i][j] = c[i][j-1]
+			}
+		}
+	}
+	return c
+}
+
+// SolveLCSDPSO returns the length of the longest common subsequence of a and b by keeping only two rows of the table.
+// DPSO stands for Dynamic Programming & Space Optimized
+func SolveLCSDPSO(a, b []byte) int {
+	return lcsLastRow(a, b, false)[len(b)]
+}
+
+// lcsLastRow returns the last row of the table of a and b, i.e. r[j] is the length of the longest common subsequence
+// of a and b[:j]. If reverse is true, both a and b are read backwards, i.e. r[j] is the length for a and b[len(b)-j:].
+func lcsLastRow(a, b []byte, reverse bool) []int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	var x, y byte
+	for i := 1; i <= len(a); i++ {
+		x = a[i-1]
+		if reverse {
+			x = a[len(a)-i]
+		}
+		for j := 1; j <= len(b); j++ {
+			y = b[j-1]
+			if reverse {
+				y = b[len(b)-j]
+			}
+			if x == y {
+				cur[j] = prev[j-1] + 1
+			} else if prev[j] >= cur[j-1] {
+				cur[j] = prev[j]
+			} else {
+				cur[j] = cur[j-1]
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+// SolveLCSHirschberg finds the longest common subsequence of a and b with Hirschberg's algorithm
+// in O(len(a)*len(b)) time and O(len(b)) space.
+// a is split in half, the split point of b is where the forward row of the first half and the backward row
+// of the second half add up to the maximum. Both halves are then solved recursively.
+// Returns the length and one of the longest common subsequences.
+func SolveLCSHirschberg(a, b []byte) (int, []byte) {
+	s := solveLCSHirschbergAux(a, b, make([]byte, 0))
+	return len(s), s
+}
+
+func solveLCSHirschbergAux(a, b, s []byte) []byte {
+	if len(a) == 0 || len(b) == 0 {
+		return s
+	}
+	if len(a) == 1 {
+		for _, y := range b {
+			if y == a[0] {
+				return append(s, y)
+			}
+		}
+		return s
+	}
+	mid := len(a) / 2
+	forward := lcsLastRow(a[:mid], b, false)
+	backward := lcsLastRow(a[mid:], b, true)
+	k := 0
+	for j := 1; j <= len(b); j++ {
+		if forward[j]+backward[len(b)-j] > forward[k]+backward[len(b)-k] {
+			k = j
+		}
+	}
+	s = solveLCSHirschbergAux(a[:mid], b[:k], s)
+	return solveLCSHirschbergAux(a[mid:], b[k:], s)
+}
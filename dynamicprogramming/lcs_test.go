@@ -0,0 +1,128 @@
+package dynamicprogramming
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// isSubsequence returns true if s is a subsequence of a
+func isSubsequence(s, a []byte) bool {
+	i := 0
+	for j := 0; i < len(s) && j < len(a); j++ {
+		if s[i] == a[j] {
+			i++
+		}
+	}
+	return i == len(s)
+}
+
+func TestLCS(t *testing.T) {
+	type args struct {
+		a, b []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "clrs",
+			args: args{[]byte("ABCBDAB"), []byte("BDCABA")},
+			want: 4,
+		},
+		{
+			name: "dna",
+			args: args{[]byte("ACCGGTCGAGTGCGCGGAAGCCGGCCGAA"), []byte("GTCGTTCGGAATGCCGTTGCTCTGTAAA")},
+			want: 20,
+		},
+		{
+			name: "empty",
+			args: args{[]byte(""), []byte("ABC")},
+			want: 0,
+		},
+		{
+			name: "nothing in common",
+			args: args{[]byte("ABC"), []byte("DEF")},
+			want: 0,
+		},
+		{
+			name: "equal",
+			args: args{[]byte("ABCDEF"), []byte("ABCDEF")},
+			want: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.args.a)+len(tt.args.b) < 20 {
+				if got := SolveLCSRecursive(tt.args.a, tt.args.b); got != tt.want {
+					t.Errorf("SolveLCSRecursive() = %v, want %v", got, tt.want)
+				}
+			}
+			if got := SolveLCSMemoized(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveLCSMemoized() = %v, want %v", got, tt.want)
+			}
+			got, s := SolveLCSTabulated(tt.args.a, tt.args.b)
+			if got != tt.want || len(s) != tt.want {
+				t.Errorf("SolveLCSTabulated() = %v, %q want %v", got, s, tt.want)
+			}
+			if !isSubsequence(s, tt.args.a) || !isSubsequence(s, tt.args.b) {
+				t.Errorf("SolveLCSTabulated() %q is not a common subsequence", s)
+			}
+			if got := SolveLCSDPSO(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SolveLCSDPSO() = %v, want %v", got, tt.want)
+			}
+			got, s = SolveLCSHirschberg(tt.args.a, tt.args.b)
+			if got != tt.want || !isSubsequence(s, tt.args.a) || !isSubsequence(s, tt.args.b) {
+				t.Errorf("SolveLCSHirschberg() = %v, %q want %v", got, s, tt.want)
+			}
+		})
+	}
+}
+
+func TestLCSHirschbergRandom(t *testing.T) {
+	for c := 0; c < 200; c++ {
+		a := make([]byte, rand.Intn(30))
+		b := make([]byte, rand.Intn(30))
+		for i := range a {
+			a[i] = byte('A' + rand.Intn(4))
+		}
+		for i := range b {
+			b[i] = byte('A' + rand.Intn(4))
+		}
+		want, _ := SolveLCSTabulated(a, b)
+		got, s := SolveLCSHirschberg(a, b)
+		if got != want || len(s) != want || !isSubsequence(s, a) || !isSubsequence(s, b) {
+			t.Fatalf("SolveLCSHirschberg(%q, %q) = %v, %q want %v", a, b, got, s, want)
+		}
+		if got := SolveLCSDPSO(a, b); got != want {
+			t.Fatalf("SolveLCSDPSO(%q, %q) = %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func BenchmarkLCS(b *testing.B) {
+	x := make([]byte, 2000)
+	y := make([]byte, 2000)
+	for i := range x {
+		x[i] = byte('A' + rand.Intn(4))
+		y[i] = byte('A' + rand.Intn(4))
+	}
+	b.Run("Tabulated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveLCSTabulated(x, y)
+		}
+	})
+	b.Run("DPSO", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveLCSDPSO(x, y)
+		}
+	})
+	b.Run("Hirschberg", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveLCSHirschberg(x, y)
+		}
+	})
+}
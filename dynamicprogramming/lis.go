@@ -0,0 +1,150 @@
+package dynamicprogramming
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Problem: find a longest strictly increasing subsequence of a given slice of integers.
+// A bitonic subsequence is strictly increasing first and then strictly decreasing, either part may be empty.
+
+// SolveLISRecursive returns the length of a longest increasing subsequence with a naive recursive approach.
+func SolveLISRecursive(input []int) int {
+	var q, r int
+	for i := range input {
+		if r = solveLISRecursiveAux(input, i); r > q {
+			q = r
+		}
+	}
+	return q
+}
+
+// solveLISRecursiveAux returns the length of a longest increasing subsequence that ends at input[i]
+func solveLISRecursiveAux(input []int, i int) int {
+	q := 1
+	for j := 0; j < i; j++ {
+		if input[j] < input[i] {
+			if r := solveLISRecursiveAux(input, j) + 1; r > q {
+				q = r
+			}
+		}
+	}
+	return q
+}
+
+// SolveLISTabulated uses bottom up approach to find a longest increasing subsequence in O(n^2) time.
+// Returns the length and the subsequence.
+func SolveLISTabulated(input []int) (int, []int) {
+	l, prev := lisEndingAt(input)
+	best := -1
+	for i := range l {
+		if best == -1 || l[i] > l[best] {
+			best = i
+		}
+	}
+	if best == -1 {
+		return 0, []int{}
+	}
+	return l[best], lisReconstruct(input, prev, best, l[best])
+}
+
+// SolveLISPatience finds a longest increasing subsequence in O(n log n) time with patience sorting.
+// tails[k] is the index of the smallest element that ends an increasing subsequence of length k+1,
+// so input values at tails are sorted and each element is placed with a binary search.
+// Returns the length and the subsequence.
+func SolveLISPatience(input []int) (int, []int) {
+	tails := make([]int, 0)
+	prev := make([]int, len(input))
+	var k int
+	for i, v := range input {
+		k = sort.Search(len(tails), func(j int) bool { return input[tails[j]] >= v })
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	if len(tails) == 0 {
+		return 0, []int{}
+	}
+	return len(tails), lisReconstruct(input, prev, tails[len(tails)-1], len(tails))
+}
+
+// SolveLongestBitonicTabulated uses bottom up approach to find a longest bitonic subsequence.
+// Returns the length and the subsequence.
+func SolveLongestBitonicTabulated(input []int) (int, []int) {
+	if len(input) == 0 {
+		return 0, []int{}
+	}
+	inc, incPrev := lisEndingAt(input)
+	// longest decreasing subsequences starting at i are longest increasing subsequences of the reversed input
+	reversed := make([]int, len(input))
+	for i, v := range input {
+		reversed[len(input)-1-i] = v
+	}
+	dec, decPrev := lisEndingAt(reversed)
+	best := 0
+	for i := range input {
+		if inc[i]+dec[len(input)-1-i] > inc[best]+dec[len(input)-1-best] {
+			best = i
+		}
+	}
+	n := inc[best] + dec[len(input)-1-best] - 1 // peak is counted twice
+	s := make([]int, 0, n)
+	s = append(s, lisReconstruct(input, incPrev, best, inc[best])...)
+	for i := decPrev[len(input)-1-best]; i != -1; i = decPrev[i] {
+		s = append(s, reversed[i])
+	}
+	return n, s
+}
+
+// lisEndingAt returns l and prev where l[i] is the length of a longest increasing subsequence that ends at input[i]
+// and prev[i] is the index of the previous element of that subsequence, -1 if there is none.
+func lisEndingAt(input []int) ([]int, []int) {
+	l := make([]int, len(input))
+	prev := make([]int, len(input))
+	for i := range input {
+		l[i] = 1
+		prev[i] = -1
+		for j := 0; j < i; j++ {
+			if input[j] < input[i] && l[j]+1 > l[i] {
+				l[i] = l[j] + 1
+				prev[i] = j
+			}
+		}
+	}
+	return l, prev
+}
+
+// lisReconstruct follows prev links back from index i and returns the subsequence of length n that ends at input[i]
+func lisReconstruct(input, prev []int, i, n int) []int {
+	s := make([]int, n)
+	for k := n - 1; k >= 0; k-- {
+		s[k] = input[i]
+		i = prev[i]
+	}
+	return s
+}
+
+// CountDistinctSubsequences returns the number of distinct non-empty subsequences of input.
+// Appending v doubles the number of subsequences, except the ones that were already created
+// when v was appended the last time.
+func CountDistinctSubsequences(input []int) *big.Int {
+	// c[i] is the number of distinct subsequences of input[:i] including the empty one
+	c := make([]*big.Int, len(input)+1)
+	c[0] = big.NewInt(1)
+	last := make(map[int]int)
+	for i, v := range input {
+		c[i+1] = new(big.Int).Lsh(c[i], 1)
+		if j, ok := last[v]; ok {
+			c[i+1].Sub(c[i+1], c[j])
+		}
+		last[v] = i
+	}
+	return c[len(input)].Sub(c[len(input)], big.NewInt(1))
+}
@@ -0,0 +1,217 @@
+package dynamicprogramming
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// isIncreasingSubsequence returns true if s is a strictly increasing subsequence of input
+func isIncreasingSubsequence(s, input []int) bool {
+	for i := 1; i < len(s); i++ {
+		if s[i-1] >= s[i] {
+			return false
+		}
+	}
+	return isIntSubsequence(s, input)
+}
+
+func isIntSubsequence(s, input []int) bool {
+	i := 0
+	for j := 0; i < len(s) && j < len(input); j++ {
+		if s[i] == input[j] {
+			i++
+		}
+	}
+	return i == len(s)
+}
+
+func TestLIS(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  int
+	}{
+		{
+			name:  "tc1",
+			input: []int{10, 9, 2, 5, 3, 7, 101, 18},
+			want:  4,
+		},
+		{
+			name:  "tc2",
+			input: []int{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15},
+			want:  6,
+		},
+		{
+			name:  "equal elements",
+			input: []int{7, 7, 7, 7},
+			want:  1,
+		},
+		{
+			name:  "decreasing",
+			input: []int{5, 4, 3, 2, 1},
+			want:  1,
+		},
+		{
+			name:  "empty",
+			input: []int{},
+			want:  0,
+		},
+		{
+			name:  "negative",
+			input: []int{-5, 3, -2, -1, 0, 2},
+			want:  5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveLISRecursive(tt.input); got != tt.want {
+				t.Errorf("SolveLISRecursive() = %v, want %v", got, tt.want)
+			}
+			if got, s := SolveLISTabulated(tt.input); got != tt.want || len(s) != tt.want || !isIncreasingSubsequence(s, tt.input) {
+				t.Errorf("SolveLISTabulated() = %v, %v want %v", got, s, tt.want)
+			}
+			if got, s := SolveLISPatience(tt.input); got != tt.want || len(s) != tt.want || !isIncreasingSubsequence(s, tt.input) {
+				t.Errorf("SolveLISPatience() = %v, %v want %v", got, s, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongestBitonic(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  int
+	}{
+		{
+			name:  "tc1",
+			input: []int{1, 11, 2, 10, 4, 5, 2, 1},
+			want:  6,
+		},
+		{
+			name:  "tc2",
+			input: []int{12, 11, 40, 5, 3, 1},
+			want:  5,
+		},
+		{
+			name:  "increasing",
+			input: []int{1, 2, 3, 4},
+			want:  4,
+		},
+		{
+			name:  "decreasing",
+			input: []int{4, 3, 2, 1},
+			want:  4,
+		},
+		{
+			name:  "empty",
+			input: []int{},
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, s := SolveLongestBitonicTabulated(tt.input)
+			if got != tt.want || len(s) != tt.want || !isIntSubsequence(s, tt.input) {
+				t.Fatalf("SolveLongestBitonicTabulated() = %v, %v want %v", got, s, tt.want)
+			}
+			peak := 0
+			for peak+1 < len(s) && s[peak] < s[peak+1] {
+				peak++
+			}
+			for i := peak + 1; i < len(s); i++ {
+				if s[i-1] <= s[i] {
+					t.Fatalf("SolveLongestBitonicTabulated() %v is not bitonic", s)
+				}
+			}
+		})
+	}
+}
+
+func TestCountDistinctSubsequences(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  string
+	}{
+		{
+			name:  "distinct elements",
+			input: []int{1, 2, 3},
+			want:  "7",
+		},
+		{
+			name:  "repeated element",
+			input: []int{1, 2, 1},
+			want:  "6",
+		},
+		{
+			name:  "same element",
+			input: []int{5, 5, 5},
+			want:  "3",
+		},
+		{
+			name:  "empty",
+			input: []int{},
+			want:  "0",
+		},
+		{
+			name:  "100 distinct elements",
+			input: rand.Perm(100),
+			want:  "1267650600228229401496703205375",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountDistinctSubsequences(tt.input); got.String() != tt.want {
+				t.Errorf("CountDistinctSubsequences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSubsequencesBruteForce compares the solvers against enumerating every subsequence of small random inputs
+func TestSubsequencesBruteForce(t *testing.T) {
+	for c := 0; c < 50; c++ {
+		input := make([]int, rand.Intn(12))
+		for i := range input {
+			input[i] = rand.Intn(6)
+		}
+		lis, bitonic := 0, 0
+		distinct := make(map[string]bool)
+		for mask := 1; mask < 1<<uint(len(input)); mask++ {
+			s := make([]int, 0)
+			for i := range input {
+				if mask&(1<<uint(i)) != 0 {
+					s = append(s, input[i])
+				}
+			}
+			distinct[fmt.Sprint(s)] = true
+			if isIncreasingSubsequence(s, s) && len(s) > lis {
+				lis = len(s)
+			}
+			peak := 0
+			for peak+1 < len(s) && s[peak] < s[peak+1] {
+				peak++
+			}
+			isBitonic := true
+			for i := peak + 1; i < len(s); i++ {
+				if s[i-1] <= s[i] {
+					isBitonic = false
+				}
+			}
+			if isBitonic && len(s) > bitonic {
+				bitonic = len(s)
+			}
+		}
+		if got, _ := SolveLISPatience(input); got != lis {
+			t.Fatalf("SolveLISPatience(%v) = %v, want %v", input, got, lis)
+		}
+		if got, _ := SolveLongestBitonicTabulated(input); got != bitonic {
+			t.Fatalf("SolveLongestBitonicTabulated(%v) = %v, want %v", input, got, bitonic)
+		}
+		if got := CountDistinctSubsequences(input); got.Int64() != int64(len(distinct)) {
+			t.Fatalf("CountDistinctSubsequences(%v) = %v, want %v", input, got, len(distinct))
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package dynamicprogramming
 
+import (
+	"math/big"
+	"strconv"
+)
+
 // SolveMatrixMultiplicationRecursive solves matrix multiplication problem with a naive recursive approach
 // Three matrices ABC can be multiplied as A(BC) or (AB)C
 // Those three matrices can be denoted as a slice of 4 parameters x, y, z, t
@@ -9,69 +14,178 @@ package dynamicprogramming
 // p := []int{x, y, z ,t}
 // solve(p[:2]) + solve(p[1:]) + p[0] * p[1] * p[3]
 // solve(p[:3]) + solve(p[2:]) + p[0] * p[2] * p[3]
+// Returns 0 if input has less than three dimensions and does not validate dimensions, see SolveMatrixMultiplicationRecursiveChecked.
+// Panics with ErrOverflow if the minimum cost does not fit into an int.
 func SolveMatrixMultiplicationRecursive(input []int) int {
-	if len(input) < 3 {
-		return 0
+	a := &arithmetic{mode: OverflowError}
+	return a.mustResult(Recursive(matrixMultiplication(input, a))(interval{0, len(input)}))
+}
+
+// SolveMatrixMultiplicationRecursiveChecked solves matrix multiplication problem with a naive recursive approach.
+// Returns ErrInvalidDimensions if input has less than two dimensions or a dimension is not positive
+// and ErrOverflow if the minimum cost does not fit into an int.
+func SolveMatrixMultiplicationRecursiveChecked(input []int) (int, error) {
+	if err := validateMatrixDimensions(input); err != nil {
+		return 0, err
+	}
+	a := &arithmetic{mode: OverflowError}
+	r := Recursive(matrixMultiplication(input, a))(interval{0, len(input)})
+	return a.result(r)
+}
+
+// SolveMatrixMultiplicationDP solves the problem by storing previously calculated values in a memo.
+// Returns 0 if input has less than three dimensions and does not validate dimensions, see SolveMatrixMultiplicationDPChecked.
+// Panics with ErrOverflow if the minimum cost does not fit into an int.
+func SolveMatrixMultiplicationDP(input []int) int {
+	a := &arithmetic{mode: OverflowError}
+	return a.mustResult(NewMemo(0).Wrap(matrixMultiplication(input, a))(interval{0, len(input)}))
+}
+
+// SolveMatrixMultiplicationDPChecked solves the problem by storing previously calculated values in a memo.
+// Returns ErrInvalidDimensions if input has less than two dimensions or a dimension is not positive
+// and ErrOverflow if the minimum cost does not fit into an int.
+func SolveMatrixMultiplicationDPChecked(input []int) (int, error) {
+	if err := validateMatrixDimensions(input); err != nil {
+		return 0, err
+	}
+	a := &arithmetic{mode: OverflowError}
+	r := NewMemo(0).Wrap(matrixMultiplication(input, a))(interval{0, len(input)})
+	return a.result(r)
+}
+
+// validateMatrixDimensions checks that input describes at least one matrix
+func validateMatrixDimensions(input []int) error {
+	if len(input) < 2 {
+		return ErrInvalidDimensions
 	}
-	if len(input) == 3 {
-		return input[0] * input[1] * input[2]
+	for _, d := range input {
+		if d <= 0 {
+			return ErrInvalidDimensions
+		}
 	}
-	var min, temp int
-	for i := 0; i < len(input)-2; i++ {
+	return nil
+}
 
-		temp = SolveMatrixMultiplicationRecursive(input[:i+2]) + // first part
-			SolveMatrixMultiplicationRecursive(input[i+1:]) + // second part
-			input[0]*input[i+1]*input[len(input)-1] // resulting matrix from first part and second part
+// matrixMultiplication returns matrix multiplication recursion for given dimensions, costs are calculated with a.
+// state is an interval k, l which denotes the matrices with dimensions input[k:l]
+func matrixMultiplication(input []int, a *arithmetic) MemoFunc {
+	return func(self func(state interface{}) int, state interface{}) int {
+		k, l := state.(interval).k, state.(interval).l
+		if l-k < 3 {
+			return 0
+		}
+		if l-k == 3 {
+			return a.mul(a.mul(input[k], input[k+1]), input[k+2])
+		}
+		var min, temp int
+		for i := k; i < l-2; i++ {
 
-		if i == 0 {
-			min = temp
-		} else {
-			if temp < min {
+			temp = a.add(a.add(self(interval{k, i + 2}), // first part
+				self(interval{i + 1, l})), // second part
+				a.mul(a.mul(input[k], input[i+1]), input[l-1])) // resulting matrix from first part and second part
+
+			if i == k {
 				min = temp
+			} else {
+				if temp < min {
+					min = temp
+				}
 			}
 		}
+		return min
 	}
-	return min
 }
 
-// SolveMatrixMultiplicationDP solves the problem by using a slice of slices for storing previously calculated values.
-func SolveMatrixMultiplicationDP(input []int) int {
-	m := make([][]int, len(input)+1)
-	for i := range m {
-		m[i] = make([]int, len(input)+1)
+// SolveMatrixMultiplicationTabulated solves the problem with a bottom up approach by using the interval engine.
+// Returns the minimum cost and an optimal parenthesization where i'th matrix is denoted as Ai (1 indexed), e.g. ((A1A2)A3)
+// Returns 0 and an empty parenthesization if input has less than two dimensions and does not validate dimensions,
+// see SolveMatrixMultiplicationTabulatedChecked. Panics with ErrOverflow if the minimum cost does not fit into an int.
+func SolveMatrixMultiplicationTabulated(input []int) (int, string) {
+	r, p, err := solveMatrixMultiplicationTabulated(input, nil, OverflowError)
+	if err != nil {
+		panic(err)
 	}
-	return solveMatrixMultiplicationDPAux(input, 0, len(input), m)
+	return r, p
 }
 
-func solveMatrixMultiplicationDPAux(input []int, k, l int, m [][]int) int {
-	if m[k][l] > 0 {
-		return m[k][l]
+// SolveMatrixMultiplicationTabulatedChecked solves the problem with a bottom up approach by using the interval engine.
+// Returns ErrInvalidDimensions if input has less than two dimensions or a dimension is not positive
+// and ErrOverflow if the minimum cost does not fit into an int.
+func SolveMatrixMultiplicationTabulatedChecked(input []int) (int, string, error) {
+	if err := validateMatrixDimensions(input); err != nil {
+		return 0, "", err
 	}
-	if l-k < 3 {
-		return 0
+	return solveMatrixMultiplicationTabulated(input, nil, OverflowError)
+}
+
+// SolveMatrixMultiplicationTabulatedTraced solves the problem with a bottom up approach by using the interval engine and calls trace,
+// if it is not nil, whenever the value of an interval improves. Row i and column j of the table is the interval [i, j].
+// Returns ErrInvalidDimensions if input has less than two dimensions or a dimension is not positive
+// and ErrOverflow if the minimum cost does not fit into an int.
+func SolveMatrixMultiplicationTabulatedTraced(input []int, trace TraceFunc) (int, string, error) {
+	if err := validateMatrixDimensions(input); err != nil {
+		return 0, "", err
 	}
-	if l-k == 3 {
-		res := input[k] * input[k+1] * input[k+2]
-		m[k][l] = res
-		return res
+	return solveMatrixMultiplicationTabulated(input, trace, OverflowError)
+}
+
+// SolveMatrixMultiplicationTabulatedMode solves the problem with a bottom up approach and handles overflowing costs as mode says.
+// Returns ErrInvalidDimensions if input has less than two dimensions or a dimension is not positive
+// and ErrOverflow if the minimum cost does not fit into an int and mode is OverflowError.
+func SolveMatrixMultiplicationTabulatedMode(input []int, mode OverflowMode) (int, string, error) {
+	if err := validateMatrixDimensions(input); err != nil {
+		return 0, "", err
 	}
+	return solveMatrixMultiplicationTabulated(input, nil, mode)
+}
 
-	var min, temp, first, second int
+// solveMatrixMultiplicationTabulated does not validate input, it only returns ErrOverflow
+func solveMatrixMultiplicationTabulated(input []int, trace TraceFunc, mode OverflowMode) (int, string, error) {
+	if len(input) < 2 {
+		return 0, "", nil
+	}
+	a := &arithmetic{mode: mode}
+	s := SolveIntervalProblem(IntervalProblem{
+		N:    len(input) - 1,
+		Base: func(i int) int { return 0 },
+		Combine: func(i, k, j, left, right int) int {
+			return a.add(a.add(left, right), a.mul(a.mul(input[i], input[k]), input[j]))
+		},
+		Trace: trace,
+	})
+	r, err := a.result(s.Best())
+	if err != nil {
+		return 0, "", err
+	}
+	return r, matrixParenthesization(s.Split, 0, len(input)-1), nil
+}
 
-	for i := k; i < l-2; i++ {
-		first = solveMatrixMultiplicationDPAux(input, k, i+2, m)
-		second = solveMatrixMultiplicationDPAux(input, i+1, l, m)
-		temp = first + second + input[k]*input[i+1]*input[l-1]
-		if i == k {
-			min = temp
-		} else {
-			if temp < min {
-				min = temp
-			}
+// SolveMatrixMultiplicationBig solves the problem with a bottom up approach and falls back to math/big if a cost does not fit into an int.
+// Returns ErrInvalidDimensions if input has less than two dimensions or a dimension is not positive.
+func SolveMatrixMultiplicationBig(input []int) (*big.Int, string, error) {
+	r, p, err := SolveMatrixMultiplicationTabulatedChecked(input)
+	if err != ErrOverflow {
+		if err != nil {
+			return nil, "", err
 		}
+		return big.NewInt(int64(r)), p, nil
 	}
+	s := solveBigIntervalProblem(len(input)-1,
+		func(i int) *big.Int { return new(big.Int) },
+		func(i, k, j int, left, right *big.Int) *big.Int {
+			v := big.NewInt(int64(input[i]))
+			v.Mul(v, big.NewInt(int64(input[k])))
+			v.Mul(v, big.NewInt(int64(input[j])))
+			return v.Add(v, left).Add(v, right)
+		}, false)
+	return s.Value[0][len(input)-1], matrixParenthesization(s.Split, 0, len(input)-1), nil
+}
 
-	m[k][l] = min
-
-	return min
+// matrixParenthesization returns the parenthesization of matrices between boundaries i and j
+func matrixParenthesization(split [][]int, i, j int) string {
+	if j-i == 1 {
+		return "A" + strconv.Itoa(j)
+	}
+	k := split[i][j]
+	return "(" + matrixParenthesization(split, i, k) + matrixParenthesization(split, k, j) + ")"
 }
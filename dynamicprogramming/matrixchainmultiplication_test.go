@@ -1,6 +1,9 @@
 package dynamicprogramming
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestSolveMatrixMultiplicationRecursive(t *testing.T) {
 	type args struct {
@@ -45,7 +48,132 @@ func TestSolveMatrixMultiplicationRecursive(t *testing.T) {
 			if got := SolveMatrixMultiplicationDP(tt.args.input); got != tt.want {
 				t.Errorf("SolveMatrixMultiplicationDP() = %v, want %v", got, tt.want)
 			}
+			if got, _ := SolveMatrixMultiplicationTabulated(tt.args.input); got != tt.want {
+				t.Errorf("SolveMatrixMultiplicationTabulated() = %v, want %v", got, tt.want)
+			}
 
 		})
 	}
 }
+
+func TestSolveMatrixMultiplicationTabulated(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  string
+	}{
+		{
+			name:  "clrs",
+			input: []int{30, 35, 15, 5, 10, 20, 25},
+			want:  "((A1(A2A3))((A4A5)A6))",
+		},
+		{
+			name:  "single matrix",
+			input: []int{10, 20},
+			want:  "A1",
+		},
+		{
+			name:  "tc2",
+			input: []int{40, 20, 30, 10, 30},
+			want:  "((A1(A2A3))A4)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := SolveMatrixMultiplicationTabulated(tt.input); got != tt.want {
+				t.Errorf("SolveMatrixMultiplicationTabulated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolveMatrixMultiplicationInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		unchecked int // result of the functions without an error return, which keep solving inputs they accepted before
+	}{
+		{
+			name:      "no dimensions",
+			input:     nil,
+			unchecked: 0,
+		},
+		{
+			name:      "no matrices",
+			input:     []int{10},
+			unchecked: 0,
+		},
+		{
+			name:      "zero dimension",
+			input:     []int{10, 0, 20},
+			unchecked: 0,
+		},
+		{
+			name:      "negative dimension",
+			input:     []int{10, 20, -30},
+			unchecked: -6000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SolveMatrixMultiplicationRecursiveChecked(tt.input); err != ErrInvalidDimensions {
+				t.Errorf("SolveMatrixMultiplicationRecursiveChecked() error = %v, want %v", err, ErrInvalidDimensions)
+			}
+			if _, err := SolveMatrixMultiplicationDPChecked(tt.input); err != ErrInvalidDimensions {
+				t.Errorf("SolveMatrixMultiplicationDPChecked() error = %v, want %v", err, ErrInvalidDimensions)
+			}
+			if _, _, err := SolveMatrixMultiplicationTabulatedChecked(tt.input); err != ErrInvalidDimensions {
+				t.Errorf("SolveMatrixMultiplicationTabulatedChecked() error = %v, want %v", err, ErrInvalidDimensions)
+			}
+			if got := SolveMatrixMultiplicationRecursive(tt.input); got != tt.unchecked {
+				t.Errorf("SolveMatrixMultiplicationRecursive() = %v, want %v", got, tt.unchecked)
+			}
+			if got := SolveMatrixMultiplicationDP(tt.input); got != tt.unchecked {
+				t.Errorf("SolveMatrixMultiplicationDP() = %v, want %v", got, tt.unchecked)
+			}
+			if got, _ := SolveMatrixMultiplicationTabulated(tt.input); got != tt.unchecked {
+				t.Errorf("SolveMatrixMultiplicationTabulated() = %v, want %v", got, tt.unchecked)
+			}
+		})
+	}
+}
+
+func TestSolveMatrixMultiplicationOverflow(t *testing.T) {
+	// every multiplication costs 2^63, which is just above math.MaxInt64
+	input := []int{1 << 21, 1 << 21, 1 << 21, 1 << 21}
+	if _, err := SolveMatrixMultiplicationRecursiveChecked(input); err != ErrOverflow {
+		t.Errorf("SolveMatrixMultiplicationRecursiveChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := SolveMatrixMultiplicationDPChecked(input); err != ErrOverflow {
+		t.Errorf("SolveMatrixMultiplicationDPChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, _, err := SolveMatrixMultiplicationTabulatedChecked(input); err != ErrOverflow {
+		t.Errorf("SolveMatrixMultiplicationTabulatedChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	checkPanics(t, "SolveMatrixMultiplicationRecursive()", ErrOverflow, func() { SolveMatrixMultiplicationRecursive(input) })
+	checkPanics(t, "SolveMatrixMultiplicationDP()", ErrOverflow, func() { SolveMatrixMultiplicationDP(input) })
+	checkPanics(t, "SolveMatrixMultiplicationTabulated()", ErrOverflow, func() { SolveMatrixMultiplicationTabulated(input) })
+	if got, _, err := SolveMatrixMultiplicationTabulatedMode(input, OverflowSaturate); got != math.MaxInt64 || err != nil {
+		t.Errorf("SolveMatrixMultiplicationTabulatedMode() = %v, %v want %v", got, err, int64(math.MaxInt64))
+	}
+	if got, _, err := SolveMatrixMultiplicationTabulatedMode(input, OverflowWrap); got != 0 || err != nil {
+		t.Errorf("SolveMatrixMultiplicationTabulatedMode() = %v, %v want 2^64 wrapped to 0", got, err)
+	}
+	got, p, err := SolveMatrixMultiplicationBig(input)
+	if err != nil || got.String() != "18446744073709551616" || (p != "((A1A2)A3)" && p != "(A1(A2A3))") {
+		t.Errorf("SolveMatrixMultiplicationBig() = %v, %v, %v want 2^64", got, p, err)
+	}
+
+	// a split that overflows does not matter if the best one fits
+	input = []int{1, 1 << 31, 1 << 31, 4}
+	want := 1<<62 + 1<<33
+	if got, err := SolveMatrixMultiplicationDPChecked(input); got != want || err != nil {
+		t.Errorf("SolveMatrixMultiplicationDPChecked() = %v, %v want %v", got, err, want)
+	}
+	if got, p, err := SolveMatrixMultiplicationBig(input); err != nil || got.Int64() != int64(want) || p != "((A1A2)A3)" {
+		t.Errorf("SolveMatrixMultiplicationBig() = %v, %v, %v want %v", got, p, err, want)
+	}
+	if _, _, err := SolveMatrixMultiplicationBig([]int{1}); err != ErrInvalidDimensions {
+		t.Errorf("SolveMatrixMultiplicationBig() error = %v, want %v", err, ErrInvalidDimensions)
+	}
+}
@@ -1,79 +1,202 @@
 package dynamicprogramming
 
+import (
+	"math/big"
+	"strconv"
+)
+
 // problem: you're given a slice of integers and you can choose to place either an addition (+) or multiplication (*) operator
 // between each number. also you can place parentheses anywhere you like. find the maximum value you can achieve.
 
+// numbers should not be negative, e.g. the product of two negative numbers can not be found by maximizing both of them.
+
 // SolveMaxNumberWithSignsRecursive solves the above problem with a naive recursive approach.
+// Does not reject negative numbers, see SolveMaxNumberWithSignsRecursiveChecked.
+// Panics with ErrOverflow if the maximum value does not fit into an int.
 func SolveMaxNumberWithSignsRecursive(input []int) int {
-	if len(input) == 0 {
-		return 0
+	a := &arithmetic{mode: OverflowError}
+	return a.mustResult(Recursive(maxNumberWithSigns(input, a))(interval{0, len(input)}))
+}
+
+// SolveMaxNumberWithSignsRecursiveChecked solves the above problem with a naive recursive approach.
+// Returns ErrNegativeInput if a number is negative and ErrOverflow if the maximum value does not fit into an int.
+func SolveMaxNumberWithSignsRecursiveChecked(input []int) (int, error) {
+	if err := validateMaxNumberWithSigns(input); err != nil {
+		return 0, err
 	}
-	if len(input) == 1 {
-		return input[0]
+	a := &arithmetic{mode: OverflowError}
+	return a.result(Recursive(maxNumberWithSigns(input, a))(interval{0, len(input)}))
+}
+
+// SolveMaxNumberWithSignsDP uses a memo to remember the solutions for sub-slices to solve the problem
+// Does not reject negative numbers, see SolveMaxNumberWithSignsDPChecked.
+// Panics with ErrOverflow if the maximum value does not fit into an int.
+func SolveMaxNumberWithSignsDP(input []int) int {
+	a := &arithmetic{mode: OverflowError}
+	return a.mustResult(NewMemo(0).Wrap(maxNumberWithSigns(input, a))(interval{0, len(input)}))
+}
+
+// SolveMaxNumberWithSignsDPChecked uses a memo to remember the solutions for sub-slices to solve the problem
+// Returns ErrNegativeInput if a number is negative and ErrOverflow if the maximum value does not fit into an int.
+func SolveMaxNumberWithSignsDPChecked(input []int) (int, error) {
+	if err := validateMaxNumberWithSigns(input); err != nil {
+		return 0, err
 	}
-	if len(input) == 2 {
-		m := input[0] * input[1]
-		s := input[0] + input[1]
-		if m > s {
-			return m
+	a := &arithmetic{mode: OverflowError}
+	return a.result(NewMemo(0).Wrap(maxNumberWithSigns(input, a))(interval{0, len(input)}))
+}
+
+func validateMaxNumberWithSigns(input []int) error {
+	for _, v := range input {
+		if v < 0 {
+			return ErrNegativeInput
 		}
-		return s
 	}
-	max := 0
-	for i := 1; i < len(input); i++ {
-		l := SolveMaxNumberWithSignsRecursive(input[:i])
-		r := SolveMaxNumberWithSignsRecursive(input[i:])
-		res := SolveMaxNumberWithSignsRecursive([]int{l, r})
-		if res > max {
-			max = res
+	return nil
+}
+
+// maxNumberWithSigns returns max number with signs recursion for given input, values are calculated with a.
+// state is an interval k, l which denotes the sub-slice input[k:l]
+func maxNumberWithSigns(input []int, a *arithmetic) MemoFunc {
+	return func(self func(state interface{}) int, state interface{}) int {
+		k, l := state.(interval).k, state.(interval).l
+		if l-k == 0 {
+			return 0
+		}
+		if l-k == 1 {
+			return input[k]
 		}
+		// max starts from the first split rather than 0, so negative numbers can give a negative maximum
+		max := 0
+		for i := k + 1; i < l; i++ {
+			left := self(interval{k, i})
+			right := self(interval{i, l})
+			mul := a.mul(left, right)
+			add := a.add(left, right)
+			if i == k+1 || mul > max {
+				max = mul
+			}
+			if add > max {
+				max = add
+			}
+		}
+		return max
 	}
-	return max
 }
 
-// SolveMaxNumberWithSignsDP uses a slice of slices to remember the solutions for sub-slices to solve the problem
-func SolveMaxNumberWithSignsDP(input []int) int {
-	m := make([][]int, len(input)+1)
-	for i := range m {
-		m[i] = make([]int, len(input)+1)
+// SolveMaxNumberWithSignsTabulated solves the problem with a bottom up approach by using the interval engine.
+// Returns the maximum value and an expression that evaluates to it, e.g. (1+2)*3
+// Does not reject negative numbers, see SolveMaxNumberWithSignsTabulatedChecked.
+// Panics with ErrOverflow if the maximum value does not fit into an int.
+func SolveMaxNumberWithSignsTabulated(input []int) (int, string) {
+	r, e, err := solveMaxNumberWithSignsTabulated(input, nil, OverflowError)
+	if err != nil {
+		panic(err)
+	}
+	return r, e
+}
+
+// SolveMaxNumberWithSignsTabulatedChecked solves the problem with a bottom up approach by using the interval engine.
+// Returns ErrNegativeInput if a number is negative and ErrOverflow if the maximum value does not fit into an int.
+func SolveMaxNumberWithSignsTabulatedChecked(input []int) (int, string, error) {
+	if err := validateMaxNumberWithSigns(input); err != nil {
+		return 0, "", err
 	}
-	return solveMaxNumberWithSignsDPAux(input, 0, len(input), m)
+	return solveMaxNumberWithSignsTabulated(input, nil, OverflowError)
 }
 
-func solveMaxNumberWithSignsDPAux(input []int, k, l int, m [][]int) int {
-	if l-k == 0 {
-		return 0
+// SolveMaxNumberWithSignsTabulatedTraced solves the problem with a bottom up approach by using the interval engine and calls trace,
+// if it is not nil, whenever the value of an interval improves. Row i and column j of the table is the interval [i, j].
+// Returns ErrNegativeInput if a number is negative and ErrOverflow if the maximum value does not fit into an int.
+func SolveMaxNumberWithSignsTabulatedTraced(input []int, trace TraceFunc) (int, string, error) {
+	if err := validateMaxNumberWithSigns(input); err != nil {
+		return 0, "", err
 	}
-	if m[k][l] > 0 {
-		return m[k][l]
+	return solveMaxNumberWithSignsTabulated(input, trace, OverflowError)
+}
+
+// SolveMaxNumberWithSignsTabulatedMode solves the problem with a bottom up approach and handles overflowing values as mode says.
+// Returns ErrNegativeInput if a number is negative
+// and ErrOverflow if the maximum value does not fit into an int and mode is OverflowError.
+func SolveMaxNumberWithSignsTabulatedMode(input []int, mode OverflowMode) (int, string, error) {
+	if err := validateMaxNumberWithSigns(input); err != nil {
+		return 0, "", err
 	}
-	if l-k == 1 {
-		m[k][l] = input[k]
-		return input[k]
+	return solveMaxNumberWithSignsTabulated(input, nil, mode)
+}
+
+// solveMaxNumberWithSignsTabulated does not validate input, it only returns ErrOverflow
+func solveMaxNumberWithSignsTabulated(input []int, trace TraceFunc, mode OverflowMode) (int, string, error) {
+	if len(input) == 0 {
+		return 0, "", nil
 	}
-	if l-k == 2 {
-		mul := input[k] * input[k+1]
-		add := input[k] + input[k+1]
-		if mul > add {
-			m[k][l] = mul
-			return mul
-		}
-		m[k][l] = add
-		return add
+	a := &arithmetic{mode: mode}
+	s := SolveIntervalProblem(IntervalProblem{
+		N:    len(input),
+		Base: func(i int) int { return input[i] },
+		Combine: func(i, k, j, left, right int) int {
+			if mul := a.mul(left, right); mul > a.add(left, right) {
+				return mul
+			}
+			return a.add(left, right)
+		},
+		Maximize: true,
+		Trace:    trace,
+	})
+	r, err := a.result(s.Best())
+	if err != nil {
+		return 0, "", err
 	}
-	max := 0
-	for i := k + 1; i < l; i++ {
-		left := solveMaxNumberWithSignsDPAux(input, k, i, m)
-		right := solveMaxNumberWithSignsDPAux(input, i, l, m)
-		mul := left * right
-		add := left + right
-		if mul > max {
-			max = mul
-		}
-		if add > max {
-			max = add
+	e := maxNumberWithSignsExpression(input, s.Split, func(i, k, j int) bool {
+		return a.mul(s.Value[i][k], s.Value[k][j]) > a.add(s.Value[i][k], s.Value[k][j])
+	}, 0, len(input))
+	return r, e, nil
+}
+
+// SolveMaxNumberWithSignsBig solves the problem with a bottom up approach and falls back to math/big if the maximum value does not fit into an int.
+// Returns ErrNegativeInput if a number is negative.
+func SolveMaxNumberWithSignsBig(input []int) (*big.Int, string, error) {
+	r, e, err := SolveMaxNumberWithSignsTabulatedChecked(input)
+	if err != ErrOverflow {
+		if err != nil {
+			return nil, "", err
 		}
+		return big.NewInt(int64(r)), e, nil
+	}
+	s := solveBigIntervalProblem(len(input),
+		func(i int) *big.Int { return big.NewInt(int64(input[i])) },
+		func(i, k, j int, left, right *big.Int) *big.Int {
+			mul := new(big.Int).Mul(left, right)
+			if add := new(big.Int).Add(left, right); add.Cmp(mul) >= 0 {
+				return add
+			}
+			return mul
+		}, true)
+	e = maxNumberWithSignsExpression(input, s.Split, func(i, k, j int) bool {
+		mul := new(big.Int).Mul(s.Value[i][k], s.Value[k][j])
+		return mul.Cmp(new(big.Int).Add(s.Value[i][k], s.Value[k][j])) > 0
+	}, 0, len(input))
+	return s.Value[0][len(input)], e, nil
+}
+
+// maxNumberWithSignsExpression returns the expression for input[i:j] without the outermost parentheses.
+// mul tells whether the two parts of interval [i, j] that is split at k are multiplied.
+func maxNumberWithSignsExpression(input []int, split [][]int, mul func(i, k, j int) bool, i, j int) string {
+	if j-i == 1 {
+		return strconv.Itoa(input[i])
+	}
+	k := split[i][j]
+	op := "+"
+	if mul(i, k, j) {
+		op = "*"
+	}
+	return maxNumberWithSignsOperand(input, split, mul, i, k) + op + maxNumberWithSignsOperand(input, split, mul, k, j)
+}
+
+// maxNumberWithSignsOperand returns the expression for input[i:j] in parentheses unless it is a single number
+func maxNumberWithSignsOperand(input []int, split [][]int, mul func(i, k, j int) bool, i, j int) string {
+	if j-i == 1 {
+		return strconv.Itoa(input[i])
 	}
-	m[k][l] = max
-	return max
+	return "(" + maxNumberWithSignsExpression(input, split, mul, i, j) + ")"
 }
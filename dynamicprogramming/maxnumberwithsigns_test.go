@@ -1,6 +1,7 @@
 package dynamicprogramming
 
 import (
+	"math"
 	"testing"
 )
 
@@ -90,6 +91,27 @@ func TestSolveMaxNumberWithSignsRecursive(t *testing.T) {
 			},
 			want: 3 * 3 * 4 * 5 * 6 * 7 * 8 * 9 * 10,
 		},
+		{
+			name: "2, -3, 4",
+			args: args{
+				input: []int{2, -3, 4},
+			},
+			want: 3,
+		},
+		{
+			name: "-5, 1",
+			args: args{
+				input: []int{-5, 1},
+			},
+			want: -4,
+		},
+		{
+			name: "-2, -3",
+			args: args{
+				input: []int{-2, -3},
+			},
+			want: 6,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -99,7 +121,128 @@ func TestSolveMaxNumberWithSignsRecursive(t *testing.T) {
 			if got := SolveMaxNumberWithSignsDP(tt.args.input); got != tt.want {
 				t.Errorf("SolveMaxNumberWithSignsDP() = %v, want %v", got, tt.want)
 			}
+			if got, _ := SolveMaxNumberWithSignsTabulated(tt.args.input); got != tt.want {
+				t.Errorf("SolveMaxNumberWithSignsTabulated() = %v, want %v", got, tt.want)
+			}
 
 		})
 	}
 }
+
+func TestSolveMaxNumberWithSignsTabulated(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  string
+	}{
+		{
+			name:  "1, 2, 3",
+			input: []int{1, 2, 3},
+			want:  "(1+2)*3",
+		},
+		{
+			name:  "1, 99, 1",
+			input: []int{1, 99, 1},
+			want:  "1+(99+1)",
+		},
+		{
+			name:  "single number",
+			input: []int{7},
+			want:  "7",
+		},
+		{
+			name:  "empty",
+			input: nil,
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := SolveMaxNumberWithSignsTabulated(tt.input); got != tt.want {
+				t.Errorf("SolveMaxNumberWithSignsTabulated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolveMaxNumberWithSignsNegative(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		unchecked int // result of the functions without an error return, which keep solving negative numbers as before
+	}{
+		{
+			name:      "2, -3, -4",
+			input:     []int{2, -3, -4},
+			unchecked: 24,
+		},
+		{
+			name:      "2, -3, 4",
+			input:     []int{2, -3, 4},
+			unchecked: 3,
+		},
+		{
+			name:      "-5, 1",
+			input:     []int{-5, 1},
+			unchecked: -4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SolveMaxNumberWithSignsRecursiveChecked(tt.input); err != ErrNegativeInput {
+				t.Errorf("SolveMaxNumberWithSignsRecursiveChecked() error = %v, want %v", err, ErrNegativeInput)
+			}
+			if _, err := SolveMaxNumberWithSignsDPChecked(tt.input); err != ErrNegativeInput {
+				t.Errorf("SolveMaxNumberWithSignsDPChecked() error = %v, want %v", err, ErrNegativeInput)
+			}
+			if _, _, err := SolveMaxNumberWithSignsTabulatedChecked(tt.input); err != ErrNegativeInput {
+				t.Errorf("SolveMaxNumberWithSignsTabulatedChecked() error = %v, want %v", err, ErrNegativeInput)
+			}
+			if got := SolveMaxNumberWithSignsRecursive(tt.input); got != tt.unchecked {
+				t.Errorf("SolveMaxNumberWithSignsRecursive() = %v, want %v", got, tt.unchecked)
+			}
+			if got := SolveMaxNumberWithSignsDP(tt.input); got != tt.unchecked {
+				t.Errorf("SolveMaxNumberWithSignsDP() = %v, want %v", got, tt.unchecked)
+			}
+			if got, _ := SolveMaxNumberWithSignsTabulated(tt.input); got != tt.unchecked {
+				t.Errorf("SolveMaxNumberWithSignsTabulated() = %v, want %v", got, tt.unchecked)
+			}
+		})
+	}
+}
+
+func TestSolveMaxNumberWithSignsOverflow(t *testing.T) {
+	input := []int{math.MaxInt64 / 2, 3}
+	if _, err := SolveMaxNumberWithSignsRecursiveChecked(input); err != ErrOverflow {
+		t.Errorf("SolveMaxNumberWithSignsRecursiveChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := SolveMaxNumberWithSignsDPChecked(input); err != ErrOverflow {
+		t.Errorf("SolveMaxNumberWithSignsDPChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, _, err := SolveMaxNumberWithSignsTabulatedChecked(input); err != ErrOverflow {
+		t.Errorf("SolveMaxNumberWithSignsTabulatedChecked() error = %v, want %v", err, ErrOverflow)
+	}
+	checkPanics(t, "SolveMaxNumberWithSignsDP()", ErrOverflow, func() { SolveMaxNumberWithSignsDP(input) })
+	checkPanics(t, "SolveMaxNumberWithSignsTabulated()", ErrOverflow, func() { SolveMaxNumberWithSignsTabulated(input) })
+	if got, e, err := SolveMaxNumberWithSignsTabulatedMode(input, OverflowSaturate); got != math.MaxInt64 || e != "4611686018427387903*3" || err != nil {
+		t.Errorf("SolveMaxNumberWithSignsTabulatedMode() = %v, %v, %v want %v", got, e, err, int64(math.MaxInt64))
+	}
+	got, e, err := SolveMaxNumberWithSignsBig(input)
+	if err != nil || got.String() != "13835058055282163709" || e != "4611686018427387903*3" {
+		t.Errorf("SolveMaxNumberWithSignsBig() = %v, %v, %v", got, e, err)
+	}
+
+	// products of many small numbers overflow too
+	input = make([]int, 70)
+	for i := range input {
+		input[i] = 2
+	}
+	input[0] = 1
+	got, _, err = SolveMaxNumberWithSignsBig(input)
+	if err != nil || got.String() != "885443715538058477568" { // 3 * 2^68
+		t.Errorf("SolveMaxNumberWithSignsBig() = %v, %v want 3 * 2^68", got, err)
+	}
+	if got, e, err := SolveMaxNumberWithSignsBig([]int{1, 2, 3}); err != nil || got.Int64() != 9 || e != "(1+2)*3" {
+		t.Errorf("SolveMaxNumberWithSignsBig() = %v, %v, %v want 9", got, e, err)
+	}
+}
@@ -0,0 +1,108 @@
+package dynamicprogramming
+
+// Memoization helper for top down solvers.
+// A solver is written once in open recursion style as a MemoFunc, i.e. it makes its recursive calls through self
+// instead of calling itself directly. Recursive runs it as a plain naive recursion
+// and Memo.Wrap runs it with previously calculated values stored in the memo.
+
+// MemoFunc is a recursive function written in open recursion style.
+// state must be comparable, i.e. usable as a map key. Recursive calls should be made through self.
+type MemoFunc func(self func(state interface{}) int, state interface{}) int
+
+// MemoStats holds memo usage statistics
+type MemoStats struct {
+	Hits, Misses, Evictions uint64
+	Size                    int
+}
+
+// Memo stores solutions of sub-problems keyed by arbitrary comparable state.
+// If the memo has a maximum size, oldest entries are evicted first. Memo is not thread-safe.
+type Memo struct {
+	values  map[interface{}]int
+	keys    []interface{} // insertion order of keys, used as a ring buffer for eviction if maxSize > 0
+	next    int           // position of the oldest key in keys once the memo is full
+	maxSize int
+	stats   MemoStats
+}
+
+// NewMemo returns a new empty Memo. maxSize <= 0 means the memo is unbounded.
+func NewMemo(maxSize int) *Memo {
+	m := &Memo{
+		values:  make(map[interface{}]int),
+		maxSize: maxSize,
+	}
+	if maxSize > 0 {
+		m.keys = make([]interface{}, 0, maxSize)
+	}
+	return m
+}
+
+// Get returns the value stored for state and whether it was found.
+func (m *Memo) Get(state interface{}) (int, bool) {
+	v, ok := m.values[state]
+	if ok {
+		m.stats.Hits++
+	} else {
+		m.stats.Misses++
+	}
+	return v, ok
+}
+
+// Put stores the value for state, evicting the oldest entry if the memo is full.
+func (m *Memo) Put(state interface{}, value int) {
+	if _, ok := m.values[state]; ok {
+		m.values[state] = value
+		return
+	}
+	if m.maxSize > 0 {
+		if len(m.keys) < m.maxSize {
+			m.keys = append(m.keys, state)
+		} else {
+			delete(m.values, m.keys[m.next])
+			m.stats.Evictions++
+			m.keys[m.next] = state
+			m.next = (m.next + 1) % m.maxSize
+		}
+	}
+	m.values[state] = value
+}
+
+// Len returns the number of stored values
+func (m *Memo) Len() int {
+	return len(m.values)
+}
+
+// Stats returns memo usage statistics
+func (m *Memo) Stats() MemoStats {
+	s := m.stats
+	s.Size = len(m.values)
+	return s
+}
+
+// Wrap returns a memoized version of f that stores its results in m.
+func (m *Memo) Wrap(f MemoFunc) func(state interface{}) int {
+	var self func(state interface{}) int
+	self = func(state interface{}) int {
+		if v, ok := m.Get(state); ok {
+			return v
+		}
+		v := f(self, state)
+		m.Put(state, v)
+		return v
+	}
+	return self
+}
+
+// Recursive returns a plain recursive version of f, i.e. nothing is remembered.
+func Recursive(f MemoFunc) func(state interface{}) int {
+	var self func(state interface{}) int
+	self = func(state interface{}) int {
+		return f(self, state)
+	}
+	return self
+}
+
+// interval is the state of problems that are solved on sub-slices input[k:l]
+type interval struct {
+	k, l int
+}
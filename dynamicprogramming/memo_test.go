@@ -0,0 +1,91 @@
+package dynamicprogramming
+
+import "testing"
+
+func fibonacci(self func(state interface{}) int, state interface{}) int {
+	n := state.(int)
+	if n <= 1 {
+		return n
+	}
+	return self(n-1) + self(n-2)
+}
+
+func TestMemo(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		maxSize int
+		want    int
+	}{
+		{
+			name:    "unbounded",
+			n:       40,
+			maxSize: 0,
+			want:    102334155,
+		},
+		{
+			name:    "bounded",
+			n:       40,
+			maxSize: 2,
+			want:    102334155,
+		},
+		{
+			name:    "bounded to 1",
+			n:       20,
+			maxSize: 1,
+			want:    6765,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMemo(tt.maxSize)
+			if got := m.Wrap(fibonacci)(tt.n); got != tt.want {
+				t.Errorf("Memo.Wrap(fibonacci)(%v) = %v, want %v", tt.n, got, tt.want)
+			}
+			s := m.Stats()
+			if tt.maxSize > 0 && s.Size > tt.maxSize {
+				t.Errorf("memo size %v exceeds maximum size %v", s.Size, tt.maxSize)
+			}
+			if s.Size != m.Len() {
+				t.Errorf("Stats().Size = %v, Len() = %v", s.Size, m.Len())
+			}
+			if tt.maxSize == 0 {
+				// every state from 0 to n is calculated exactly once
+				if s.Misses != uint64(tt.n+1) || s.Hits != uint64(tt.n-2) || s.Evictions != 0 {
+					t.Errorf("unexpected stats %+v", s)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoPut(t *testing.T) {
+	m := NewMemo(2)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("a", 3) // update does not evict
+	if v, ok := m.Get("a"); !ok || v != 3 {
+		t.Fatalf("Get(a) = %v, %v want 3, true", v, ok)
+	}
+	m.Put("c", 4) // evicts a, the oldest entry
+	if _, ok := m.Get("a"); ok {
+		t.Fatal("a should have been evicted")
+	}
+	if v, ok := m.Get("b"); !ok || v != 2 {
+		t.Fatalf("Get(b) = %v, %v want 2, true", v, ok)
+	}
+	m.Put(interval{1, 2}, 5) // evicts b
+	if v, ok := m.Get(interval{1, 2}); !ok || v != 5 {
+		t.Fatalf("Get(interval{1, 2}) = %v, %v want 5, true", v, ok)
+	}
+	s := m.Stats()
+	if s.Hits != 3 || s.Misses != 1 || s.Evictions != 2 || s.Size != 2 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
+
+func TestRecursive(t *testing.T) {
+	if got := Recursive(fibonacci)(20); got != 6765 {
+		t.Errorf("Recursive(fibonacci)(20) = %v, want 6765", got)
+	}
+}
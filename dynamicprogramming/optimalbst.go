@@ -0,0 +1,92 @@
+package dynamicprogramming
+
+import (
+	"bytes"
+
+	"algorithms/datastructures/binarysearchtree"
+)
+
+// Introduction to Algorithms 3rd Edition, Section 15.5
+
+// Problem: given n distinct keys k0 < k1 < ... < kn-1 and how often each of them is searched for,
+// build a binary search tree whose expected search cost is minimal.
+// p[i] is the search frequency of key ki, q[i] is the search frequency of values that are between ki-1 and ki,
+// i.e. unsuccessful searches, which end at dummy key di. q has n+1 elements, q[0] is below k0 and q[n] is above kn-1.
+// Search cost of a key is its depth + 1, and the cost of a tree is the sum of frequency * search cost for all keys and dummy keys.
+
+// Interval engine boundaries for optimal binary search tree:
+// boundaries are 0 to n+1, interval [i, j] holds keys ki, ..., kj-2 and dummy keys di, ..., dj-1.
+// Splitting [i, j] at boundary k means key kk-1 is the root, [i, k] is its left and [k, j] is its right subtree.
+// Unit interval [i, i+1] holds only dummy key di.
+
+// SolveOptimalBSTTabulated returns the expected search cost of an optimal binary search tree with a bottom up approach.
+// p holds key frequencies and q holds dummy key frequencies, i.e. len(q) should be len(p) + 1.
+func SolveOptimalBSTTabulated(p, q []int) int {
+	return optimalBST(p, q, false).Best()
+}
+
+// SolveOptimalBSTKnuth returns the expected search cost of an optimal binary search tree with a bottom up approach
+// in O(n^2) time by using Knuth's observation that root[i][j-1] <= root[i][j] <= root[i+1][j].
+func SolveOptimalBSTKnuth(p, q []int) int {
+	return optimalBST(p, q, true).Best()
+}
+
+// NewOptimalBST returns a binarysearchtree.Tree of the key value pairs whose shape is an optimal binary search tree,
+// and the expected search cost of the tree.
+// pairs should be sorted by their keys in increasing order and keys should be distinct.
+// p[i] is the search frequency of pairs[i].Key, q is the dummy key frequencies, i.e. len(q) should be len(pairs) + 1.
+// Returns ErrInvalidDimensions if the lengths of p or q do not match pairs, ErrUnsortedKeys if keys are not increasing
+// and ErrNegativeInput if a frequency is negative.
+func NewOptimalBST(pairs []*binarysearchtree.KVPair, p, q []int) (*binarysearchtree.Tree, int, error) {
+	if len(p) != len(pairs) || len(q) != len(pairs)+1 {
+		return nil, 0, ErrInvalidDimensions
+	}
+	for i := 1; i < len(pairs); i++ {
+		if bytes.Compare(pairs[i-1].Key, pairs[i].Key) >= 0 {
+			return nil, 0, ErrUnsortedKeys
+		}
+	}
+	for i := range q {
+		if q[i] < 0 || (i < len(p) && p[i] < 0) {
+			return nil, 0, ErrNegativeInput
+		}
+	}
+	s := optimalBST(p, q, true)
+	t := binarysearchtree.NewTree()
+	// inserting keys in preorder of a binary search tree builds a tree with the same shape
+	stack := []interval{{0, len(pairs) + 1}}
+	var in interval
+	var k int
+	for len(stack) > 0 {
+		in = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if in.l-in.k < 2 {
+			continue // only a dummy key
+		}
+		k = s.Split[in.k][in.l]
+		t.Insert(pairs[k-1].Key, pairs[k-1].Value)
+		stack = append(stack, interval{k, in.l}, interval{in.k, k})
+	}
+	return t, s.Best(), nil
+}
+
+func optimalBST(p, q []int, knuth bool) *IntervalSolution {
+	// prefix sums so that weight of an interval is calculated in constant time
+	wp := make([]int, len(p)+1)
+	wq := make([]int, len(q)+1)
+	for i := range p {
+		wp[i+1] = wp[i] + p[i]
+	}
+	for i := range q {
+		wq[i+1] = wq[i] + q[i]
+	}
+	return SolveIntervalProblem(IntervalProblem{
+		N:    len(p) + 1,
+		Base: func(i int) int { return q[i] },
+		Combine: func(i, k, j, left, right int) int {
+			// every node in [i, j] is one level deeper than it would be in its subtree
+			return left + right + wp[j-1] - wp[i] + wq[j] - wq[i]
+		},
+		Knuth: knuth,
+	})
+}
@@ -0,0 +1,207 @@
+package dynamicprogramming
+
+import (
+	"algorithms/datastructures/binarysearchtree"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSolveOptimalBSTTabulated(t *testing.T) {
+	type args struct {
+		p, q []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "clrs",
+			args: args{
+				p: []int{15, 10, 5, 10, 20},
+				q: []int{5, 10, 5, 5, 5, 10},
+			},
+			want: 275,
+		},
+		{
+			name: "no keys",
+			args: args{
+				p: []int{},
+				q: []int{3},
+			},
+			want: 3,
+		},
+		{
+			name: "single key",
+			args: args{
+				p: []int{4},
+				q: []int{1, 2},
+			},
+			want: 4 + 2*1 + 2*2,
+		},
+		{
+			name: "successful searches only",
+			args: args{
+				p: []int{1, 1, 1},
+				q: []int{0, 0, 0, 0},
+			},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveOptimalBSTTabulated(tt.args.p, tt.args.q); got != tt.want {
+				t.Errorf("SolveOptimalBSTTabulated() = %v, want %v", got, tt.want)
+			}
+			if got := SolveOptimalBSTKnuth(tt.args.p, tt.args.q); got != tt.want {
+				t.Errorf("SolveOptimalBSTKnuth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func randomBSTFrequencies(n int) ([]int, []int) {
+	p := make([]int, n)
+	q := make([]int, n+1)
+	for i := range p {
+		p[i] = rand.Intn(100)
+	}
+	for i := range q {
+		q[i] = rand.Intn(20)
+	}
+	return p, q
+}
+
+func TestSolveOptimalBSTKnuthRandom(t *testing.T) {
+	for n := 0; n < 40; n++ {
+		p, q := randomBSTFrequencies(n)
+		if got, want := SolveOptimalBSTKnuth(p, q), SolveOptimalBSTTabulated(p, q); got != want {
+			t.Fatalf("SolveOptimalBSTKnuth() = %v, SolveOptimalBSTTabulated() = %v, p: %v, q: %v", got, want, p, q)
+		}
+	}
+}
+
+// bstSearchCost returns the expected search cost of a tree, keys should be sorted.
+// An unsuccessful search between two keys ends one level below the deeper of them.
+func bstSearchCost(tree *binarysearchtree.Tree, keys [][]byte, p, q []int) int {
+	cost := 0
+	prev := -1
+	for i, k := range keys {
+		d := tree.Depth(k)
+		cost += (d + 1) * p[i]
+		if d > prev {
+			prev = d
+		}
+		cost += (prev + 2) * q[i]
+		prev = d
+	}
+	cost += (prev + 2) * q[len(keys)]
+	return cost
+}
+
+func TestNewOptimalBST(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 5, 10, 50, 100} {
+		t.Run(fmt.Sprintf("%v keys", n), func(t *testing.T) {
+			p, q := randomBSTFrequencies(n)
+			pairs := make([]*binarysearchtree.KVPair, n)
+			keys := make([][]byte, n)
+			for i := range pairs {
+				keys[i] = []byte(fmt.Sprintf("key_%03d", i))
+				pairs[i] = &binarysearchtree.KVPair{Key: keys[i], Value: []byte(fmt.Sprint(i))}
+			}
+			tree, cost, err := NewOptimalBST(pairs, p, q)
+			if err != nil {
+				t.Fatalf("NewOptimalBST() error = %v", err)
+			}
+			if tree.Length() != uint64(n) {
+				t.Fatalf("tree length %v, want %v", tree.Length(), n)
+			}
+			for _, kv := range pairs {
+				if string(tree.Get(kv.Key)) != string(kv.Value) {
+					t.Fatalf("tree value for %s is %s, want %s", kv.Key, tree.Get(kv.Key), kv.Value)
+				}
+			}
+			if got := bstSearchCost(tree, keys, p, q); got != cost {
+				t.Fatalf("tree search cost is %v, NewOptimalBST() returned %v", got, cost)
+			}
+			// no insertion order should build a tree with a lower expected search cost
+			for i := 0; i < 20; i++ {
+				random := binarysearchtree.NewTree()
+				for _, j := range rand.Perm(n) {
+					random.Insert(pairs[j].Key, pairs[j].Value)
+				}
+				if r := bstSearchCost(random, keys, p, q); r < cost {
+					t.Fatalf("random insertion order search cost %v is less than optimal cost %v", r, cost)
+				}
+			}
+		})
+	}
+}
+
+func TestNewOptimalBSTInvalid(t *testing.T) {
+	pair := func(key string) *binarysearchtree.KVPair {
+		return &binarysearchtree.KVPair{Key: []byte(key), Value: []byte(key)}
+	}
+	sorted := []*binarysearchtree.KVPair{pair("a"), pair("b"), pair("c")}
+	tests := []struct {
+		name  string
+		pairs []*binarysearchtree.KVPair
+		p, q  []int
+		want  error
+	}{
+		{
+			name:  "valid",
+			pairs: sorted,
+			p:     []int{3, 1, 2},
+			q:     []int{1, 0, 1, 1},
+			want:  nil,
+		},
+		{
+			name:  "missing key frequency",
+			pairs: sorted,
+			p:     []int{3, 1},
+			q:     []int{1, 0, 1, 1},
+			want:  ErrInvalidDimensions,
+		},
+		{
+			name:  "missing dummy key frequency",
+			pairs: sorted,
+			p:     []int{3, 1, 2},
+			q:     []int{1, 0, 1},
+			want:  ErrInvalidDimensions,
+		},
+		{
+			name:  "unsorted keys",
+			pairs: []*binarysearchtree.KVPair{pair("a"), pair("c"), pair("b")},
+			p:     []int{3, 1, 2},
+			q:     []int{1, 0, 1, 1},
+			want:  ErrUnsortedKeys,
+		},
+		{
+			name:  "duplicate keys",
+			pairs: []*binarysearchtree.KVPair{pair("a"), pair("b"), pair("b")},
+			p:     []int{3, 1, 2},
+			q:     []int{1, 0, 1, 1},
+			want:  ErrUnsortedKeys,
+		},
+		{
+			name:  "negative frequency",
+			pairs: sorted,
+			p:     []int{3, -1, 2},
+			q:     []int{1, 0, 1, 1},
+			want:  ErrNegativeInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, _, err := NewOptimalBST(tt.pairs, tt.p, tt.q)
+			if err != tt.want {
+				t.Fatalf("NewOptimalBST() error = %v, want %v", err, tt.want)
+			}
+			if err == nil && tree.Length() != uint64(len(tt.pairs)) {
+				t.Errorf("tree length %v, want %v", tree.Length(), len(tt.pairs))
+			}
+		})
+	}
+}
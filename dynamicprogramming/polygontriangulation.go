@@ -0,0 +1,34 @@
+package dynamicprogramming
+
+// Problem: a convex polygon with n vertices v0, v1, ..., vn-1 can be divided into n-2 triangles by non-crossing diagonals.
+// Given a weight function for triangles, find the triangulation that minimizes the total weight.
+// Every triangulation has a triangle (v0, vk, vn-1) on the edge (v0, vn-1), which splits the polygon into two smaller polygons.
+
+// SolvePolygonTriangulationTabulated solves polygon triangulation problem with a bottom up approach by using the interval engine.
+// n is the number of vertices and weight(i, k, j) is the weight of the triangle with vertices vi, vk and vj where i < k < j.
+// Returns the minimum total weight and the triangles of the triangulation as vertex index triples.
+func SolvePolygonTriangulationTabulated(n int, weight func(i, k, j int) int) (int, [][3]int) {
+	if n < 3 {
+		return 0, nil
+	}
+	s := SolveIntervalProblem(IntervalProblem{
+		N:    n - 1,
+		Base: func(i int) int { return 0 }, // an edge of the polygon
+		Combine: func(i, k, j, left, right int) int {
+			return left + right + weight(i, k, j)
+		},
+	})
+	triangles := make([][3]int, 0, n-2)
+	return s.Best(), polygonTriangles(s.Split, 0, n-1, triangles)
+}
+
+// polygonTriangles appends the triangles of the polygon vi, ..., vj to triangles
+func polygonTriangles(split [][]int, i, j int, triangles [][3]int) [][3]int {
+	if j-i < 2 {
+		return triangles
+	}
+	k := split[i][j]
+	triangles = append(triangles, [3]int{i, k, j})
+	triangles = polygonTriangles(split, i, k, triangles)
+	return polygonTriangles(split, k, j, triangles)
+}
@@ -0,0 +1,57 @@
+package dynamicprogramming
+
+import "testing"
+
+func TestSolvePolygonTriangulationTabulated(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   int
+	}{
+		{
+			name:   "triangle",
+			values: []int{1, 2, 3},
+			want:   6,
+		},
+		{
+			name:   "quadrilateral",
+			values: []int{3, 7, 4, 5},
+			want:   144,
+		},
+		{
+			name:   "hexagon",
+			values: []int{1, 3, 1, 4, 1, 5},
+			want:   13,
+		},
+		{
+			name:   "not a polygon",
+			values: []int{1, 2},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// weight of a triangle is the product of its vertex values
+			weight := func(i, k, j int) int {
+				return tt.values[i] * tt.values[k] * tt.values[j]
+			}
+			got, triangles := SolvePolygonTriangulationTabulated(len(tt.values), weight)
+			if got != tt.want {
+				t.Errorf("SolvePolygonTriangulationTabulated() = %v, want %v", got, tt.want)
+			}
+			if len(tt.values) < 3 {
+				return
+			}
+			if len(triangles) != len(tt.values)-2 {
+				t.Fatalf("SolvePolygonTriangulationTabulated() returned %v triangles, want %v", len(triangles), len(tt.values)-2)
+			}
+			total := 0
+			for _, tr := range triangles {
+				total += weight(tr[0], tr[1], tr[2])
+			}
+			if total != tt.want {
+				t.Errorf("SolvePolygonTriangulationTabulated() triangles %v weigh %v, want %v", triangles, total, tt.want)
+			}
+		})
+	}
+}
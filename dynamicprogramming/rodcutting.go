@@ -10,45 +10,111 @@ package dynamicprogramming
 // with memoization added to recursive solution.
 // price of a rod of length i is stored in prices[i-1]
 // length is the length of the rod
+// Panics if length is negative or greater than len(prices) and does not validate prices, see SolveRodCuttingMemoizedChecked.
 func SolveRodCuttingMemoized(prices []int, length int) int {
-	if length <= 0 {
-		return 0
+	if err := validateRodLength(prices, length, true); err != nil {
+		panic(err)
 	}
-	mem := make([]int, length)
-	return solveRodCuttingMemoizedAux(prices, mem, length)
+	return NewMemo(0).Wrap(rodCutting(prices))(length)
 }
 
-func solveRodCuttingMemoizedAux(prices, mem []int, length int) int {
-	if length <= 0 {
-		return 0
+// SolveRodCuttingMemoizedChecked uses top down approach to solve rod-cutting problem with memoization.
+// Returns ErrNegativeInput if length or a price is negative and ErrOutOfRange if length is greater than len(prices).
+func SolveRodCuttingMemoizedChecked(prices []int, length int) (int, error) {
+	if err := validateRodCutting(prices, length, true); err != nil {
+		return 0, err
 	}
-	if mem[length-1] > 0 {
-		return mem[length-1]
+	return NewMemo(0).Wrap(rodCutting(prices))(length), nil
+}
+
+// rodCutting returns rod-cutting recursion for given prices, state is the length of the rod
+func rodCutting(prices []int) MemoFunc {
+	return func(self func(state interface{}) int, state interface{}) int {
+		length := state.(int)
+		if length <= 0 {
+			return 0
+		}
+		var q, r int
+		for i := 1; i <= length; i++ {
+			r = prices[i-1] + self(length-i)
+			if r > q {
+				q = r
+			}
+		}
+		return q
 	}
-	var q, r int
-	for i := 1; i <= length; i++ {
-		r = prices[i-1] + solveRodCuttingMemoizedAux(prices, mem, length-i)
-		if r > q {
-			q = r
+}
+
+// validateRodCutting checks that length and prices are not negative.
+// If priced is true, there should be a price for every length up to the length of the rod.
+func validateRodCutting(prices []int, length int, priced bool) error {
+	if err := validateRodLength(prices, length, priced); err != nil {
+		return err
+	}
+	for _, p := range prices {
+		if p < 0 {
+			return ErrNegativeInput
 		}
 	}
-	mem[length-1] = q
-	return q
+	return nil
+}
+
+// validateRodLength checks length as validateRodCutting does, but not the prices
+func validateRodLength(prices []int, length int, priced bool) error {
+	if length < 0 {
+		return ErrNegativeInput
+	}
+	if priced && length > len(prices) {
+		return ErrOutOfRange
+	}
+	return nil
 }
 
 // SolveRodCuttingTabulated uses bottom up approach to solve rod-cutting problem given a slice of prices for each rod of length i
 // price of a rod of length i is stored in prices[i-1]
 // length is the length of the rod
+// Panics if length is negative or greater than len(prices) and does not validate prices, see SolveRodCuttingTabulatedChecked.
 func SolveRodCuttingTabulated(prices []int, length int) int {
+	if err := validateRodLength(prices, length, true); err != nil {
+		panic(err)
+	}
+	return rodCuttingTabulated(prices, length, nil)
+}
+
+// SolveRodCuttingTabulatedChecked uses bottom up approach to solve rod-cutting problem.
+// Returns ErrNegativeInput if length or a price is negative and ErrOutOfRange if length is greater than len(prices).
+func SolveRodCuttingTabulatedChecked(prices []int, length int) (int, error) {
+	return SolveRodCuttingTabulatedTraced(prices, length, nil)
+}
+
+// SolveRodCuttingTabulatedTraced uses bottom up approach to solve rod-cutting problem and calls trace, if it is not nil,
+// whenever the best value for a rod length improves. The table has a single row and column l is the rod of length l,
+// Split of a step is the length of the first piece.
+// Returns ErrNegativeInput if length or a price is negative and ErrOutOfRange if length is greater than len(prices).
+func SolveRodCuttingTabulatedTraced(prices []int, length int, trace TraceFunc) (int, error) {
+	if err := validateRodCutting(prices, length, true); err != nil {
+		return 0, err
+	}
+	return rodCuttingTabulated(prices, length, trace), nil
+}
+
+// rodCuttingTabulated fills the table of SolveRodCuttingTabulatedTraced, it's assumed that length is at most len(prices)
+func rodCuttingTabulated(prices []int, length int, trace TraceFunc) int {
+	if length <= 0 {
+		return 0
+	}
 	r := make([]int, length)
 	r[0] = prices[0]
+	trace.emit(TraceStep{Row: 0, Col: 1, Value: r[0], Split: 1})
 	var q, v int
 	for i := 1; i < length; i++ {
 		q = prices[i] // default is no cuts
-		for j := 1; j < i; j++ {
+		trace.emit(TraceStep{Row: 0, Col: i + 1, Value: q, Split: i + 1})
+		for j := 1; j <= i; j++ {
 			v = prices[j-1] + r[i-j]
 			if v > q {
 				q = v
+				trace.emit(TraceStep{Row: 0, Col: i + 1, Value: q, Split: j})
 			}
 		}
 		r[i] = q
@@ -59,16 +125,62 @@ func SolveRodCuttingTabulated(prices []int, length int) int {
 // SolveRodCuttingRecursiveTopDown solves rod-cutting problem given a slice of prices for each length of i with a recursive top down approach.
 // price of a rod of length i is stored in prices[i-1]
 // length is the length of the rod
+// Panics if length is negative or greater than len(prices) and does not validate prices, see SolveRodCuttingRecursiveTopDownChecked.
 func SolveRodCuttingRecursiveTopDown(prices []int, length int) int {
+	if err := validateRodLength(prices, length, true); err != nil {
+		panic(err)
+	}
+	return Recursive(rodCutting(prices))(length)
+}
+
+// SolveRodCuttingRecursiveTopDownChecked solves rod-cutting problem with a recursive top down approach.
+// Returns ErrNegativeInput if length or a price is negative and ErrOutOfRange if length is greater than len(prices).
+func SolveRodCuttingRecursiveTopDownChecked(prices []int, length int) (int, error) {
+	if err := validateRodCutting(prices, length, true); err != nil {
+		return 0, err
+	}
+	return Recursive(rodCutting(prices))(length), nil
+}
+
+// SolveRodCuttingDPSO solves rod-cutting problem by keeping only the last len(prices) values instead of the whole table,
+// so memory usage does not depend on the length of the rod.
+// price of a rod of length i is stored in prices[i-1], pieces longer than len(prices) can not be sold.
+// length is the length of the rod
+// DPSO stands for Dynamic Programming & Space Optimized
+// Panics if length is negative and does not validate prices, see SolveRodCuttingDPSOChecked.
+func SolveRodCuttingDPSO(prices []int, length int) int {
+	if err := validateRodLength(prices, length, false); err != nil {
+		panic(err)
+	}
+	return rodCuttingDPSO(prices, length)
+}
+
+// SolveRodCuttingDPSOChecked solves rod-cutting problem by keeping only the last len(prices) values.
+// Returns ErrNegativeInput if length or a price is negative.
+func SolveRodCuttingDPSOChecked(prices []int, length int) (int, error) {
+	if err := validateRodCutting(prices, length, false); err != nil {
+		return 0, err
+	}
+	return rodCuttingDPSO(prices, length), nil
+}
+
+// rodCuttingDPSO keeps the last len(prices) values of the table of SolveRodCuttingDPSO
+func rodCuttingDPSO(prices []int, length int) int {
 	if length <= 0 {
 		return 0
 	}
-	var q, r int
+	// r[i%len(r)] is the best value for a rod of length i
+	r := make([]int, len(prices)+1)
+	var q, v int
 	for i := 1; i <= length; i++ {
-		r = prices[i-1] + SolveRodCuttingRecursiveTopDown(prices, length-i)
-		if r > q {
-			q = r
+		q = 0
+		for j := 1; j <= i && j <= len(prices); j++ {
+			v = prices[j-1] + r[(i-j)%len(r)]
+			if v > q {
+				q = v
+			}
 		}
+		r[i%len(r)] = q
 	}
-	return q
+	return r[length%len(r)]
 }
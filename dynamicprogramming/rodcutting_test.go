@@ -36,6 +36,15 @@ func TestRodCutting(t *testing.T) {
 			},
 			want: 30,
 		},
+		{
+			// r[i] is the rod of length i+1, so its first piece j goes up to i. a rod of length 2 is only cut with j = i = 1
+			name: "two equal pieces",
+			args: args{
+				[]int{3, 5},
+				2,
+			},
+			want: 6,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -48,7 +57,125 @@ func TestRodCutting(t *testing.T) {
 			if got := SolveRodCuttingTabulated(tt.args.prices, tt.args.length); got != tt.want {
 				t.Errorf("SolveRodCuttingTabulated() = %v, want %v", got, tt.want)
 			}
+			if got := SolveRodCuttingDPSO(tt.args.prices, tt.args.length); got != tt.want {
+				t.Errorf("SolveRodCuttingDPSO() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRodCuttingDPSO(t *testing.T) {
+	// the rod is longer than the longest piece with a price
+	prices := []int{1, 5, 8, 9}
+	length := 100
+	padded := make([]int, length)
+	copy(padded, prices)
+	want := SolveRodCuttingTabulated(padded, length)
+	if want != 266 {
+		t.Fatalf("SolveRodCuttingTabulated() = %v, want %v", want, 266)
+	}
+	if got := SolveRodCuttingDPSO(prices, length); got != want {
+		t.Errorf("SolveRodCuttingDPSO() = %v, want %v", got, want)
+	}
+	if got := SolveRodCuttingDPSO(prices, 0); got != 0 {
+		t.Errorf("SolveRodCuttingDPSO() = %v, want %v", got, 0)
+	}
+}
+
+func BenchmarkRodCutting(b *testing.B) {
+	prices := []int{1, 5, 8, 9, 10, 17, 17, 20, 24, 30}
+	length := 2000
+	padded := make([]int, length)
+	copy(padded, prices)
+	b.Run("Tabulated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveRodCuttingTabulated(padded, length)
+		}
+	})
+	b.Run("DPSO", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SolveRodCuttingDPSO(prices, length)
+		}
+	})
+}
 
+func TestRodCuttingInvalid(t *testing.T) {
+	type args struct {
+		prices []int
+		length int
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      error
+		dpso      error // SolveRodCuttingDPSOChecked accepts rods longer than the price list
+		unchecked int   // result of the functions without an error return, which do not validate prices
+		panics    error // the functions without an error return panic for the lengths that their Checked variants reject
+		dpsoPanic error
+	}{
+		{
+			name:      "negative length",
+			args:      args{[]int{1, 5}, -1},
+			want:      ErrNegativeInput,
+			dpso:      ErrNegativeInput,
+			panics:    ErrNegativeInput,
+			dpsoPanic: ErrNegativeInput,
+		},
+		{
+			name:      "negative price",
+			args:      args{[]int{-1, 5}, 2},
+			want:      ErrNegativeInput,
+			dpso:      ErrNegativeInput,
+			unchecked: 5,
+		},
+		{
+			name:      "rod longer than prices",
+			args:      args{[]int{1, 5}, 3},
+			want:      ErrOutOfRange,
+			dpso:      nil,
+			unchecked: 6,
+			panics:    ErrOutOfRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SolveRodCuttingRecursiveTopDownChecked(tt.args.prices, tt.args.length); err != tt.want {
+				t.Errorf("SolveRodCuttingRecursiveTopDownChecked() error = %v, want %v", err, tt.want)
+			}
+			if _, err := SolveRodCuttingMemoizedChecked(tt.args.prices, tt.args.length); err != tt.want {
+				t.Errorf("SolveRodCuttingMemoizedChecked() error = %v, want %v", err, tt.want)
+			}
+			if _, err := SolveRodCuttingTabulatedChecked(tt.args.prices, tt.args.length); err != tt.want {
+				t.Errorf("SolveRodCuttingTabulatedChecked() error = %v, want %v", err, tt.want)
+			}
+			if _, err := SolveRodCuttingDPSOChecked(tt.args.prices, tt.args.length); err != tt.dpso {
+				t.Errorf("SolveRodCuttingDPSOChecked() error = %v, want %v", err, tt.dpso)
+			}
+			if tt.dpsoPanic != nil {
+				checkPanics(t, "SolveRodCuttingDPSO()", tt.dpsoPanic, func() { SolveRodCuttingDPSO(tt.args.prices, tt.args.length) })
+			} else if got := SolveRodCuttingDPSO(tt.args.prices, tt.args.length); got != tt.unchecked {
+				t.Errorf("SolveRodCuttingDPSO() = %v, want %v", got, tt.unchecked)
+			}
+			if tt.panics != nil {
+				checkPanics(t, "SolveRodCuttingRecursiveTopDown()", tt.panics, func() { SolveRodCuttingRecursiveTopDown(tt.args.prices, tt.args.length) })
+				checkPanics(t, "SolveRodCuttingMemoized()", tt.panics, func() { SolveRodCuttingMemoized(tt.args.prices, tt.args.length) })
+				checkPanics(t, "SolveRodCuttingTabulated()", tt.panics, func() { SolveRodCuttingTabulated(tt.args.prices, tt.args.length) })
+				return
+			}
+			if got := SolveRodCuttingRecursiveTopDown(tt.args.prices, tt.args.length); got != tt.unchecked {
+				t.Errorf("SolveRodCuttingRecursiveTopDown() = %v, want %v", got, tt.unchecked)
+			}
+			if got := SolveRodCuttingMemoized(tt.args.prices, tt.args.length); got != tt.unchecked {
+				t.Errorf("SolveRodCuttingMemoized() = %v, want %v", got, tt.unchecked)
+			}
+			if got := SolveRodCuttingTabulated(tt.args.prices, tt.args.length); got != tt.unchecked {
+				t.Errorf("SolveRodCuttingTabulated() = %v, want %v", got, tt.unchecked)
+			}
 		})
 	}
+	if got, err := SolveRodCuttingTabulatedChecked(nil, 0); got != 0 || err != nil {
+		t.Errorf("SolveRodCuttingTabulatedChecked() = %v, %v want 0 for an empty rod", got, err)
+	}
 }
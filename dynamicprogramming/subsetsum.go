@@ -0,0 +1,191 @@
+package dynamicprogramming
+
+// Problem: given a set of non-negative integers, is there a subset that adds up to a given sum?
+// Equal partition problem asks whether the set can be split into two subsets with equal sums,
+// which is the subset sum problem for half of the total.
+
+// subsetState is the state of subset sum recursion, i.e. whether sum can be made up by using elements of set[:i]
+type subsetState struct {
+	i, sum int
+}
+
+// SolveSubsetSumRecursive returns true if a subset of set adds up to sum with a naive recursive approach.
+// Panics if the input is invalid, see SolveSubsetSumRecursiveChecked.
+func SolveSubsetSumRecursive(set []int, sum int) bool {
+	r, err := SolveSubsetSumRecursiveChecked(set, sum)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveSubsetSumRecursiveChecked returns true if a subset of set adds up to sum with a naive recursive approach.
+// Returns ErrNegativeInput if sum or an element of set is negative.
+func SolveSubsetSumRecursiveChecked(set []int, sum int) (bool, error) {
+	if err := validateSubsetSum(set, sum); err != nil {
+		return false, err
+	}
+	return Recursive(subsetSum(set))(subsetState{len(set), sum}) == 1, nil
+}
+
+// SolveSubsetSumMemoized returns true if a subset of set adds up to sum by storing previously calculated values in a memo.
+// Panics if the input is invalid, see SolveSubsetSumMemoizedChecked.
+func SolveSubsetSumMemoized(set []int, sum int) bool {
+	r, err := SolveSubsetSumMemoizedChecked(set, sum)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveSubsetSumMemoizedChecked returns true if a subset of set adds up to sum by using a memo.
+// Returns ErrNegativeInput if sum or an element of set is negative.
+func SolveSubsetSumMemoizedChecked(set []int, sum int) (bool, error) {
+	if err := validateSubsetSum(set, sum); err != nil {
+		return false, err
+	}
+	return NewMemo(0).Wrap(subsetSum(set))(subsetState{len(set), sum}) == 1, nil
+}
+
+// validateSubsetSum checks that sum and the elements of set are not negative
+func validateSubsetSum(set []int, sum int) error {
+	if sum < 0 {
+		return ErrNegativeInput
+	}
+	for _, v := range set {
+		if v < 0 {
+			return ErrNegativeInput
+		}
+	}
+	return nil
+}
+
+// subsetSum returns subset sum recursion for given set, whose elements must not be negative.
+// state is a subsetState, 1 means true and 0 means false.
+func subsetSum(set []int) MemoFunc {
+	return func(self func(state interface{}) int, state interface{}) int {
+		s := state.(subsetState)
+		if s.sum == 0 {
+			return 1
+		}
+		if s.sum < 0 || s.i == 0 {
+			return 0
+		}
+		if self(subsetState{s.i - 1, s.sum}) == 1 {
+			return 1
+		}
+		return self(subsetState{s.i - 1, s.sum - set[s.i-1]})
+	}
+}
+
+// SolveSubsetSumTabulated uses bottom up approach to find a subset of set that adds up to sum.
+// Returns whether there is such a subset and the subset itself.
+// Panics if the input is invalid, see SolveSubsetSumTabulatedChecked.
+func SolveSubsetSumTabulated(set []int, sum int) (bool, []int) {
+	ok, subset, err := SolveSubsetSumTabulatedChecked(set, sum)
+	if err != nil {
+		panic(err)
+	}
+	return ok, subset
+}
+
+// SolveSubsetSumTabulatedChecked uses bottom up approach to find a subset of set that adds up to sum.
+// Returns ErrNegativeInput if sum or an element of set is negative.
+func SolveSubsetSumTabulatedChecked(set []int, sum int) (bool, []int, error) {
+	if err := validateSubsetSum(set, sum); err != nil {
+		return false, nil, err
+	}
+	// t[i][s] is true if s can be made up by using elements of set[:i]
+	t := make([][]bool, len(set)+1)
+	for i := range t {
+		t[i] = make([]bool, sum+1)
+		t[i][0] = true
+	}
+	for i := 1; i <= len(set); i++ {
+		for s := 1; s <= sum; s++ {
+			t[i][s] = t[i-1][s] || (set[i-1] <= s && t[i-1][s-set[i-1]])
+		}
+	}
+	if !t[len(set)][sum] {
+		return false, nil, nil
+	}
+	subset := make([]int, 0)
+	s := sum
+	for i := len(set); i > 0 && s > 0; i-- {
+		if !t[i-1][s] {
+			subset = append(subset, set[i-1])
+			s -= set[i-1]
+		}
+	}
+	return true, subset, nil
+}
+
+// SolveEqualPartitionRecursive returns true if set can be split into two subsets with equal sums with a naive recursive approach.
+// Panics if the input is invalid, see SolveEqualPartitionRecursiveChecked.
+func SolveEqualPartitionRecursive(set []int) bool {
+	r, err := SolveEqualPartitionRecursiveChecked(set)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveEqualPartitionRecursiveChecked returns true if set can be split into two subsets with equal sums with a naive recursive approach.
+// Returns ErrNegativeInput if an element of set is negative.
+func SolveEqualPartitionRecursiveChecked(set []int) (bool, error) {
+	total := sumOf(set)
+	if total%2 != 0 {
+		return false, validateSubsetSum(set, 0)
+	}
+	return SolveSubsetSumRecursiveChecked(set, total/2)
+}
+
+// SolveEqualPartitionMemoized returns true if set can be split into two subsets with equal sums
+// by storing previously calculated values in a memo.
+// Panics if the input is invalid, see SolveEqualPartitionMemoizedChecked.
+func SolveEqualPartitionMemoized(set []int) bool {
+	r, err := SolveEqualPartitionMemoizedChecked(set)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SolveEqualPartitionMemoizedChecked returns true if set can be split into two subsets with equal sums by using a memo.
+// Returns ErrNegativeInput if an element of set is negative.
+func SolveEqualPartitionMemoizedChecked(set []int) (bool, error) {
+	total := sumOf(set)
+	if total%2 != 0 {
+		return false, validateSubsetSum(set, 0)
+	}
+	return SolveSubsetSumMemoizedChecked(set, total/2)
+}
+
+// SolveEqualPartitionTabulated uses bottom up approach to split set into two subsets with equal sums.
+// Returns whether it is possible and the elements of one of the subsets.
+// Panics if the input is invalid, see SolveEqualPartitionTabulatedChecked.
+func SolveEqualPartitionTabulated(set []int) (bool, []int) {
+	ok, subset, err := SolveEqualPartitionTabulatedChecked(set)
+	if err != nil {
+		panic(err)
+	}
+	return ok, subset
+}
+
+// SolveEqualPartitionTabulatedChecked uses bottom up approach to split set into two subsets with equal sums.
+// Returns ErrNegativeInput if an element of set is negative.
+func SolveEqualPartitionTabulatedChecked(set []int) (bool, []int, error) {
+	total := sumOf(set)
+	if total%2 != 0 {
+		return false, nil, validateSubsetSum(set, 0)
+	}
+	return SolveSubsetSumTabulatedChecked(set, total/2)
+}
+
+func sumOf(set []int) int {
+	total := 0
+	for _, v := range set {
+		total += v
+	}
+	return total
+}
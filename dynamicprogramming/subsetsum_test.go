@@ -0,0 +1,192 @@
+package dynamicprogramming
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSubsetSum(t *testing.T) {
+	type args struct {
+		set []int
+		sum int
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "tc1",
+			args: args{[]int{3, 34, 4, 12, 5, 2}, 9},
+			want: true,
+		},
+		{
+			name: "tc2",
+			args: args{[]int{3, 34, 4, 12, 5, 2}, 30},
+			want: false,
+		},
+		{
+			name: "empty set",
+			args: args{[]int{}, 0},
+			want: true,
+		},
+		{
+			name: "zeros",
+			args: args{[]int{0, 0, 7}, 7},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveSubsetSumRecursive(tt.args.set, tt.args.sum); got != tt.want {
+				t.Errorf("SolveSubsetSumRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveSubsetSumMemoized(tt.args.set, tt.args.sum); got != tt.want {
+				t.Errorf("SolveSubsetSumMemoized() = %v, want %v", got, tt.want)
+			}
+			got, subset := SolveSubsetSumTabulated(tt.args.set, tt.args.sum)
+			if got != tt.want {
+				t.Errorf("SolveSubsetSumTabulated() = %v, want %v", got, tt.want)
+			}
+			if got && sumOf(subset) != tt.args.sum {
+				t.Errorf("SolveSubsetSumTabulated() subset %v does not add up to %v", subset, tt.args.sum)
+			}
+		})
+	}
+}
+
+func TestEqualPartition(t *testing.T) {
+	tests := []struct {
+		name string
+		set  []int
+		want bool
+	}{
+		{
+			name: "tc1",
+			set:  []int{1, 5, 11, 5},
+			want: true,
+		},
+		{
+			name: "tc2",
+			set:  []int{1, 2, 3, 5},
+			want: false,
+		},
+		{
+			name: "odd total",
+			set:  []int{1, 2, 4},
+			want: false,
+		},
+		{
+			name: "tc3",
+			set:  []int{3, 1, 1, 2, 2, 1},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SolveEqualPartitionRecursive(tt.set); got != tt.want {
+				t.Errorf("SolveEqualPartitionRecursive() = %v, want %v", got, tt.want)
+			}
+			if got := SolveEqualPartitionMemoized(tt.set); got != tt.want {
+				t.Errorf("SolveEqualPartitionMemoized() = %v, want %v", got, tt.want)
+			}
+			got, half := SolveEqualPartitionTabulated(tt.set)
+			if got != tt.want {
+				t.Errorf("SolveEqualPartitionTabulated() = %v, want %v", got, tt.want)
+			}
+			if got && 2*sumOf(half) != sumOf(tt.set) {
+				t.Errorf("SolveEqualPartitionTabulated() subset %v is not half of %v", half, tt.set)
+			}
+		})
+	}
+}
+
+func TestSubsetSumRandom(t *testing.T) {
+	for c := 0; c < 50; c++ {
+		set := make([]int, rand.Intn(10))
+		for i := range set {
+			set[i] = rand.Intn(20)
+		}
+		sum := rand.Intn(60)
+		want, _ := SolveSubsetSumTabulated(set, sum)
+		if got := SolveSubsetSumRecursive(set, sum); got != want {
+			t.Fatalf("SolveSubsetSumRecursive(%v, %v) = %v, want %v", set, sum, got, want)
+		}
+		if got := SolveSubsetSumMemoized(set, sum); got != want {
+			t.Fatalf("SolveSubsetSumMemoized(%v, %v) = %v, want %v", set, sum, got, want)
+		}
+	}
+}
+
+func TestSubsetSumInvalid(t *testing.T) {
+	type args struct {
+		set []int
+		sum int
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      error
+		partition error // equal partition has no sum
+	}{
+		{
+			name:      "negative sum",
+			args:      args{[]int{1, 2}, -3},
+			want:      ErrNegativeInput,
+			partition: nil,
+		},
+		{
+			name:      "negative element",
+			args:      args{[]int{4, -1, 3}, 3},
+			want:      ErrNegativeInput,
+			partition: ErrNegativeInput,
+		},
+		{
+			// -2 + 5 = 3, so pruning negative sums used to miss it
+			name:      "negative element in the subset",
+			args:      args{[]int{-2, 5}, 3},
+			want:      ErrNegativeInput,
+			partition: ErrNegativeInput,
+		},
+		{
+			name:      "negative element with an odd total",
+			args:      args{[]int{-2, 5, 4}, 2},
+			want:      ErrNegativeInput,
+			partition: ErrNegativeInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, sum := tt.args.set, tt.args.sum
+			errs := map[string]error{}
+			_, errs["SolveSubsetSumRecursiveChecked()"] = SolveSubsetSumRecursiveChecked(set, sum)
+			_, errs["SolveSubsetSumMemoizedChecked()"] = SolveSubsetSumMemoizedChecked(set, sum)
+			_, _, errs["SolveSubsetSumTabulatedChecked()"] = SolveSubsetSumTabulatedChecked(set, sum)
+			for name, err := range errs {
+				if err != tt.want {
+					t.Errorf("%v error = %v, want %v", name, err, tt.want)
+				}
+			}
+			errs = map[string]error{}
+			_, errs["SolveEqualPartitionRecursiveChecked()"] = SolveEqualPartitionRecursiveChecked(set)
+			_, errs["SolveEqualPartitionMemoizedChecked()"] = SolveEqualPartitionMemoizedChecked(set)
+			_, _, errs["SolveEqualPartitionTabulatedChecked()"] = SolveEqualPartitionTabulatedChecked(set)
+			for name, err := range errs {
+				if err != tt.partition {
+					t.Errorf("%v error = %v, want %v", name, err, tt.partition)
+				}
+			}
+			checkPanics(t, "SolveSubsetSumRecursive()", tt.want, func() { SolveSubsetSumRecursive(set, sum) })
+			checkPanics(t, "SolveSubsetSumMemoized()", tt.want, func() { SolveSubsetSumMemoized(set, sum) })
+			checkPanics(t, "SolveSubsetSumTabulated()", tt.want, func() { SolveSubsetSumTabulated(set, sum) })
+			if tt.partition != nil {
+				checkPanics(t, "SolveEqualPartitionRecursive()", tt.partition, func() { SolveEqualPartitionRecursive(set) })
+				checkPanics(t, "SolveEqualPartitionMemoized()", tt.partition, func() { SolveEqualPartitionMemoized(set) })
+				checkPanics(t, "SolveEqualPartitionTabulated()", tt.partition, func() { SolveEqualPartitionTabulated(set) })
+			}
+		})
+	}
+	if ok, subset, err := SolveSubsetSumTabulatedChecked([]int{3, 4, 5}, 9); !ok || len(subset) != 2 || err != nil {
+		t.Errorf("SolveSubsetSumTabulatedChecked() = %v, %v, %v want 4 and 5", ok, subset, err)
+	}
+}
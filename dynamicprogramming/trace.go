@@ -0,0 +1,191 @@
+package dynamicprogramming
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Tracing of bottom up solvers.
+// A traced solver reports every update of its table as a TraceStep, so the table can be watched while it fills.
+// DPTable records the steps and renders the final table as text, CSV or an HTML heat map.
+
+// TraceStep is a single update of a cell of a dynamic programming table.
+type TraceStep struct {
+	Row, Col int
+	Value    int
+	Split    int // the choice that gave the value, e.g. a split point, -1 if there is none
+}
+
+// TraceFunc is called by traced solvers for every cell update, in order.
+type TraceFunc func(step TraceStep)
+
+// emit calls f if it is not nil
+func (f TraceFunc) emit(step TraceStep) {
+	if f != nil {
+		f(step)
+	}
+}
+
+// DPTable records the steps of a traced solver. The zero value is an empty table ready to use, e.g.
+// var table DPTable
+// SolveRodCuttingTabulatedTraced(prices, length, table.Record)
+type DPTable struct {
+	Cells  [][]int     // Cells[i][j] is the last value of cell i, j
+	Filled [][]bool    // Filled[i][j] is true if cell i, j was updated at least once
+	Steps  []TraceStep // all updates in order
+}
+
+// Record stores the step, the table grows as needed.
+func (t *DPTable) Record(step TraceStep) {
+	for len(t.Cells) <= step.Row {
+		t.Cells = append(t.Cells, nil)
+		t.Filled = append(t.Filled, nil)
+	}
+	for len(t.Cells[step.Row]) <= step.Col {
+		t.Cells[step.Row] = append(t.Cells[step.Row], 0)
+		t.Filled[step.Row] = append(t.Filled[step.Row], false)
+	}
+	t.Cells[step.Row][step.Col] = step.Value
+	t.Filled[step.Row][step.Col] = true
+	t.Steps = append(t.Steps, step)
+}
+
+// cols returns the number of columns of the widest row
+func (t *DPTable) cols() int {
+	n := 0
+	for _, row := range t.Cells {
+		if len(row) > n {
+			n = len(row)
+		}
+	}
+	return n
+}
+
+// cell returns the value of cell i, j as a string, empty if it was never updated
+func (t *DPTable) cell(i, j int) string {
+	if j >= len(t.Cells[i]) || !t.Filled[i][j] {
+		return ""
+	}
+	return strconv.Itoa(t.Cells[i][j])
+}
+
+// WriteText writes the table with aligned columns, row and column indices are in the margins
+// and cells that were never updated are shown as a dot.
+func (t *DPTable) WriteText(w io.Writer) error {
+	cols := t.cols()
+	rows := make([][]string, len(t.Cells)+1)
+	rows[0] = make([]string, cols+1)
+	for j := 0; j < cols; j++ {
+		rows[0][j+1] = strconv.Itoa(j)
+	}
+	for i := range t.Cells {
+		rows[i+1] = make([]string, cols+1)
+		rows[i+1][0] = strconv.Itoa(i)
+		for j := 0; j < cols; j++ {
+			if rows[i+1][j+1] = t.cell(i, j); rows[i+1][j+1] == "" {
+				rows[i+1][j+1] = "."
+			}
+		}
+	}
+	width := make([]int, cols+1)
+	for _, row := range rows {
+		for j, c := range row {
+			if len(c) > width[j] {
+				width[j] = len(c)
+			}
+		}
+	}
+	for _, row := range rows {
+		line := make([]string, len(row))
+		for j, c := range row {
+			line[j] = strings.Repeat(" ", width[j]-len(c)) + c
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(line, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes the table as CSV with a header of column indices and the row index as the first field.
+// Cells that were never updated are empty.
+func (t *DPTable) WriteCSV(w io.Writer) error {
+	cols := t.cols()
+	cw := csv.NewWriter(w)
+	record := make([]string, cols+1)
+	for j := 0; j < cols; j++ {
+		record[j+1] = strconv.Itoa(j)
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for i := range t.Cells {
+		record[0] = strconv.Itoa(i)
+		for j := 0; j < cols; j++ {
+			record[j+1] = t.cell(i, j)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteHTML writes the table as a standalone HTML page with the given title.
+// Cells are shaded from light for the minimum value to dark for the maximum value, cells that were never updated are blank.
+func (t *DPTable) WriteHTML(w io.Writer, title string) error {
+	lo, hi, found := 0, 0, false
+	for i, row := range t.Cells {
+		for j, v := range row {
+			if !t.Filled[i][j] {
+				continue
+			}
+			if !found || v < lo {
+				lo = v
+			}
+			if !found || v > hi {
+				hi = v
+			}
+			found = true
+		}
+	}
+	var b strings.Builder
+	title = html.EscapeString(title)
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n", title)
+	b.WriteString("<style>table{border-collapse:collapse;font-family:monospace}td,th{border:1px solid #ccc;padding:4px 8px;text-align:right}</style>\n")
+	fmt.Fprintf(&b, "</head>\n<body>\n<h1>%s</h1>\n<table>\n<tr><th></th>", title)
+	cols := t.cols()
+	for j := 0; j < cols; j++ {
+		fmt.Fprintf(&b, "<th>%d</th>", j)
+	}
+	b.WriteString("</tr>\n")
+	for i := range t.Cells {
+		fmt.Fprintf(&b, "<tr><th>%d</th>", i)
+		for j := 0; j < cols; j++ {
+			c := t.cell(i, j)
+			if c == "" {
+				b.WriteString("<td></td>")
+				continue
+			}
+			// lightness goes from 95% for the minimum down to 45% for the maximum
+			lightness := 95
+			if hi > lo {
+				lightness -= int((float64(t.Cells[i][j]) - float64(lo)) / (float64(hi) - float64(lo)) * 50)
+			}
+			color := "#000"
+			if lightness < 65 {
+				color = "#fff"
+			}
+			fmt.Fprintf(&b, "<td style=\"background:hsl(210,80%%,%d%%);color:%s\">%s</td>", lightness, color, c)
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</table>\n</body>\n</html>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
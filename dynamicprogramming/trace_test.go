@@ -0,0 +1,97 @@
+package dynamicprogramming
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRodCuttingTraced(t *testing.T) {
+	var table DPTable
+	got, err := SolveRodCuttingTabulatedTraced([]int{1, 5, 8, 9}, 4, table.Record)
+	if got != 10 || err != nil {
+		t.Fatalf("SolveRodCuttingTabulatedTraced() = %v, %v want %v", got, err, 10)
+	}
+	want := []TraceStep{
+		{0, 1, 1, 1},
+		{0, 2, 5, 2},
+		{0, 3, 8, 3},
+		{0, 4, 9, 4},
+		{0, 4, 10, 2},
+	}
+	if len(table.Steps) != len(want) {
+		t.Fatalf("steps = %v, want %v", table.Steps, want)
+	}
+	for i := range want {
+		if table.Steps[i] != want[i] {
+			t.Fatalf("steps = %v, want %v", table.Steps, want)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := table.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+	if text := "  0 1 2 3  4\n0 . 1 5 8 10\n"; b.String() != text {
+		t.Errorf("WriteText() = %q, want %q", b.String(), text)
+	}
+	b.Reset()
+	if err := table.WriteCSV(&b); err != nil {
+		t.Fatal(err)
+	}
+	if csv := ",0,1,2,3,4\n0,,1,5,8,10\n"; b.String() != csv {
+		t.Errorf("WriteCSV() = %q, want %q", b.String(), csv)
+	}
+}
+
+func TestMatrixMultiplicationTraced(t *testing.T) {
+	var table DPTable
+	input := []int{30, 35, 15, 5, 10, 20, 25}
+	got, p, err := SolveMatrixMultiplicationTabulatedTraced(input, table.Record)
+	if got != 15125 || p != "((A1(A2A3))((A4A5)A6))" || err != nil {
+		t.Fatalf("SolveMatrixMultiplicationTabulatedTraced() = %v, %v, %v", got, p, err)
+	}
+	// the final table is the m table of CLRS figure 15.5
+	for _, c := range []struct{ i, j, want int }{{0, 6, 15125}, {1, 4, 4375}, {2, 6, 5375}, {0, 2, 15750}, {3, 4, 0}} {
+		if table.Cells[c.i][c.j] != c.want || !table.Filled[c.i][c.j] {
+			t.Errorf("Cells[%v][%v] = %v, want %v", c.i, c.j, table.Cells[c.i][c.j], c.want)
+		}
+	}
+	if table.Filled[4][3] {
+		t.Error("cells below the diagonal should not be filled")
+	}
+	// values of an interval only improve
+	last := make(map[[2]int]int)
+	for _, s := range table.Steps {
+		if v, ok := last[[2]int{s.Row, s.Col}]; ok && s.Value >= v {
+			t.Fatalf("step %v does not improve %v", s, v)
+		}
+		last[[2]int{s.Row, s.Col}] = s.Value
+	}
+
+	var b bytes.Buffer
+	if err := table.WriteHTML(&b, "matrix <chain>"); err != nil {
+		t.Fatal(err)
+	}
+	page := b.String()
+	for _, want := range []string{"<title>matrix &lt;chain&gt;</title>", "hsl(210,80%,45%);color:#fff\">15750</td>", "hsl(210,80%,95%);color:#000\">0</td>"} {
+		if !strings.Contains(page, want) {
+			t.Errorf("WriteHTML() does not contain %q", want)
+		}
+	}
+}
+
+func TestMaxNumberWithSignsTraced(t *testing.T) {
+	var table DPTable
+	input := []int{1, 2, 3}
+	got, e, err := SolveMaxNumberWithSignsTabulatedTraced(input, table.Record)
+	if got != 9 || e != "(1+2)*3" || err != nil {
+		t.Fatalf("SolveMaxNumberWithSignsTabulatedTraced() = %v, %v, %v", got, e, err)
+	}
+	if table.Cells[0][3] != 9 || table.Cells[0][2] != 3 || table.Cells[1][2] != 2 {
+		t.Errorf("table = %v", table.Cells)
+	}
+	if _, _, err := SolveMaxNumberWithSignsTabulatedTraced([]int{-1}, table.Record); err != ErrNegativeInput {
+		t.Errorf("SolveMaxNumberWithSignsTabulatedTraced() error = %v, want %v", err, ErrNegativeInput)
+	}
+}
@@ -0,0 +1,228 @@
+package dynamicprogramming
+
+import "algorithms/datastructures/binarysearchtree"
+
+// Tree dynamic programming. A value is calculated for every subtree from the values of its children's subtrees,
+// so vertices are processed children first. Traversals are iterative, so degenerate trees,
+// e.g. binary search trees built from sorted inserts, do not cause deep recursion.
+// maximum independent set: the heaviest set of vertices such that no two of them are adjacent.
+// minimum vertex cover: the lightest set of vertices such that every edge has at least one end in the set.
+// diameter: the longest path between any two vertices.
+// distance sums: for every vertex, the sum of its distances to all other vertices. It is solved by rerooting,
+// i.e. the answer for the root is moved to each child in constant time.
+
+// RootedTree is a tree whose vertices are 0 to n-1.
+type RootedTree struct {
+	Root     int
+	Parent   []int   // Parent[v] is the parent of v, -1 for the root
+	Children [][]int // Children[v] lists the children of v
+}
+
+// NewRootedTree returns a rooted tree given the parent of each vertex, where the root's parent is -1.
+// Returns nil if parent does not describe a tree with a single root.
+func NewRootedTree(parent []int) *RootedTree {
+	t := &RootedTree{
+		Root:     -1,
+		Parent:   parent,
+		Children: make([][]int, len(parent)),
+	}
+	for v, p := range parent {
+		if p == -1 {
+			if t.Root != -1 {
+				return nil
+			}
+			t.Root = v
+			continue
+		}
+		if p < 0 || p >= len(parent) {
+			return nil
+		}
+		t.Children[p] = append(t.Children[p], v)
+	}
+	if t.Root == -1 || len(t.order()) != len(parent) {
+		return nil // there is a cycle
+	}
+	return t
+}
+
+// NewRootedTreeFromBST returns a rooted tree with the same shape as a binary search tree,
+// and the key value pairs of the tree, where pairs[v] is the pair at vertex v.
+func NewRootedTreeFromBST(bst *binarysearchtree.Tree) (*RootedTree, []*binarysearchtree.KVPair) {
+	pairs, parent := bst.Shape()
+	if len(pairs) == 0 {
+		return &RootedTree{Root: -1}, pairs
+	}
+	return NewRootedTree(parent), pairs
+}
+
+// order returns the vertices in breadth first order from the root, so every vertex comes after its parent
+func (t *RootedTree) order() []int {
+	if t.Root == -1 {
+		return nil
+	}
+	order := []int{t.Root}
+	for i := 0; i < len(order); i++ {
+		order = append(order, t.Children[order[i]]...)
+	}
+	return order
+}
+
+// weightOf returns the weight of v, every vertex weighs 1 if weight is nil
+func weightOf(weight []int, v int) int {
+	if weight == nil {
+		return 1
+	}
+	return weight[v]
+}
+
+// SolveTreeMaxIndependentSet finds a maximum weight independent set of a tree.
+// weight[v] is the weight of vertex v, every vertex weighs 1 if weight is nil.
+// Returns the weight of the set and its vertices.
+func SolveTreeMaxIndependentSet(t *RootedTree, weight []int) (int, []int) {
+	order := t.order()
+	if len(order) == 0 {
+		return 0, nil
+	}
+	// in[v] is the best for the subtree of v if v is in the set, out[v] if it is not
+	in := make([]int, len(t.Parent))
+	out := make([]int, len(t.Parent))
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		in[v] = weightOf(weight, v)
+		for _, c := range t.Children[v] {
+			in[v] += out[c]
+			if in[c] > out[c] {
+				out[v] += in[c]
+			} else {
+				out[v] += out[c]
+			}
+		}
+	}
+	// walk down from the root, a vertex can be taken only if its parent is not taken
+	taken := make([]bool, len(t.Parent))
+	set := make([]int, 0)
+	for _, v := range order {
+		if (v == t.Root || !taken[t.Parent[v]]) && in[v] > out[v] {
+			taken[v] = true
+			set = append(set, v)
+		}
+	}
+	if in[t.Root] > out[t.Root] {
+		return in[t.Root], set
+	}
+	return out[t.Root], set
+}
+
+// SolveTreeMinVertexCover finds a minimum weight vertex cover of a tree.
+// weight[v] is the weight of vertex v, every vertex weighs 1 if weight is nil.
+// Returns the weight of the cover and its vertices.
+func SolveTreeMinVertexCover(t *RootedTree, weight []int) (int, []int) {
+	order := t.order()
+	if len(order) == 0 {
+		return 0, nil
+	}
+	// in[v] is the best for the subtree of v if v is in the cover, out[v] if it is not, in which case all children must be
+	in := make([]int, len(t.Parent))
+	out := make([]int, len(t.Parent))
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		in[v] = weightOf(weight, v)
+		for _, c := range t.Children[v] {
+			out[v] += in[c]
+			if in[c] < out[c] {
+				in[v] += in[c]
+			} else {
+				in[v] += out[c]
+			}
+		}
+	}
+	// walk down from the root, a vertex must be taken if its parent is not taken
+	taken := make([]bool, len(t.Parent))
+	cover := make([]int, 0)
+	for _, v := range order {
+		if (v != t.Root && !taken[t.Parent[v]]) || in[v] < out[v] {
+			taken[v] = true
+			cover = append(cover, v)
+		}
+	}
+	if in[t.Root] < out[t.Root] {
+		return in[t.Root], cover
+	}
+	return out[t.Root], cover
+}
+
+// SolveTreeDiameter finds a longest path of a tree, where the length of a path is its number of edges.
+// Returns the length and the vertices of the path in order.
+func SolveTreeDiameter(t *RootedTree) (int, []int) {
+	order := t.order()
+	if len(order) == 0 {
+		return 0, nil
+	}
+	// height[v] is the length of the longest downward path from v and down[v] is the child on that path, -1 for leaves
+	height := make([]int, len(t.Parent))
+	down := make([]int, len(t.Parent))
+	best, top, second := -1, -1, -1
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		down[v] = -1
+		// longest path that turns at v goes down through its two highest children
+		first, next := -1, -1
+		for _, c := range t.Children[v] {
+			if first == -1 || height[c] > height[first] {
+				first, next = c, first
+			} else if next == -1 || height[c] > height[next] {
+				next = c
+			}
+		}
+		if first != -1 {
+			height[v] = height[first] + 1
+			down[v] = first
+		}
+		length := height[v]
+		if next != -1 {
+			length += height[next] + 1
+		}
+		if length > best {
+			best, top, second = length, v, next
+		}
+	}
+	path := make([]int, 0, best+1)
+	for v := top; v != -1; v = down[v] {
+		path = append(path, v)
+	}
+	for k, l := 0, len(path)-1; k < l; k, l = k+1, l-1 {
+		path[k], path[l] = path[l], path[k]
+	}
+	for v := second; v != -1; v = down[v] {
+		path = append(path, v)
+	}
+	return best, path
+}
+
+// SolveTreeDistanceSums returns sums where sums[v] is the sum of the distances from v to all other vertices.
+// sums[root] is calculated bottom up. Moving from a parent p to its child c brings the size[c] vertices in the subtree of c
+// one step closer and the other n - size[c] vertices one step further, i.e. sums[c] = sums[p] - size[c] + n - size[c].
+func SolveTreeDistanceSums(t *RootedTree) []int {
+	order := t.order()
+	n := len(order)
+	sums := make([]int, len(t.Parent))
+	if n == 0 {
+		return sums
+	}
+	size := make([]int, len(t.Parent))
+	// down[v] is the sum of the distances from v to the vertices in its subtree
+	down := make([]int, len(t.Parent))
+	for i := n - 1; i >= 0; i-- {
+		v := order[i]
+		size[v] = 1
+		for _, c := range t.Children[v] {
+			size[v] += size[c]
+			down[v] += down[c] + size[c]
+		}
+	}
+	sums[t.Root] = down[t.Root]
+	for _, v := range order[1:] {
+		sums[v] = sums[t.Parent[v]] - size[v] + n - size[v]
+	}
+	return sums
+}
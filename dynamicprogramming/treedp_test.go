@@ -0,0 +1,287 @@
+package dynamicprogramming
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"algorithms/datastructures/binarysearchtree"
+)
+
+func TestNewRootedTree(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent []int
+		valid  bool
+	}{
+		{
+			name:   "path",
+			parent: []int{-1, 0, 1},
+			valid:  true,
+		},
+		{
+			name:   "star",
+			parent: []int{1, -1, 1, 1},
+			valid:  true,
+		},
+		{
+			name:   "two roots",
+			parent: []int{-1, -1},
+			valid:  false,
+		},
+		{
+			name:   "no root",
+			parent: []int{1, 0},
+			valid:  false,
+		},
+		{
+			name:   "cycle",
+			parent: []int{-1, 2, 1},
+			valid:  false,
+		},
+		{
+			name:   "out of range",
+			parent: []int{-1, 5},
+			valid:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRootedTree(tt.parent); (got != nil) != tt.valid {
+				t.Errorf("NewRootedTree(%v) = %v, valid %v", tt.parent, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestTreeDP(t *testing.T) {
+	type want struct {
+		mis, cover, diameter int
+		sums                 []int
+	}
+	tests := []struct {
+		name   string
+		parent []int
+		weight []int
+		want   want
+	}{
+		{
+			name:   "single vertex",
+			parent: []int{-1},
+			want:   want{1, 0, 0, []int{0}},
+		},
+		{
+			name:   "path",
+			parent: []int{-1, 0, 1, 2, 3},
+			want:   want{3, 2, 4, []int{10, 7, 6, 7, 10}},
+		},
+		{
+			name:   "star",
+			parent: []int{-1, 0, 0, 0},
+			want:   want{3, 1, 2, []int{3, 5, 5, 5}},
+		},
+		{
+			name:   "weighted star",
+			parent: []int{-1, 0, 0, 0},
+			weight: []int{10, 1, 2, 3},
+			want:   want{10, 6, 2, []int{3, 5, 5, 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := NewRootedTree(tt.parent)
+			if got, set := SolveTreeMaxIndependentSet(tree, tt.weight); got != tt.want.mis || !isIndependentSet(tree, set, tt.weight, got) {
+				t.Errorf("SolveTreeMaxIndependentSet() = %v %v, want %v", got, set, tt.want.mis)
+			}
+			if got, cover := SolveTreeMinVertexCover(tree, tt.weight); got != tt.want.cover || !isVertexCover(tree, cover, tt.weight, got) {
+				t.Errorf("SolveTreeMinVertexCover() = %v %v, want %v", got, cover, tt.want.cover)
+			}
+			if got, path := SolveTreeDiameter(tree); got != tt.want.diameter || !isTreePath(tree, path, got) {
+				t.Errorf("SolveTreeDiameter() = %v %v, want %v", got, path, tt.want.diameter)
+			}
+			if got := SolveTreeDistanceSums(tree); fmt.Sprint(got) != fmt.Sprint(tt.want.sums) {
+				t.Errorf("SolveTreeDistanceSums() = %v, want %v", got, tt.want.sums)
+			}
+		})
+	}
+}
+
+func TestTreeDPRandom(t *testing.T) {
+	for c := 0; c < 100; c++ {
+		n := 1 + rand.Intn(12)
+		parent := make([]int, n)
+		weight := make([]int, n)
+		perm := rand.Perm(n)
+		for i, v := range perm {
+			parent[v] = -1
+			if i > 0 {
+				parent[v] = perm[rand.Intn(i)]
+			}
+			weight[v] = rand.Intn(10)
+		}
+		tree := NewRootedTree(parent)
+		mis, cover := bruteForceTreeSets(tree, weight)
+		if got, set := SolveTreeMaxIndependentSet(tree, weight); got != mis || !isIndependentSet(tree, set, weight, got) {
+			t.Fatalf("SolveTreeMaxIndependentSet(%v, %v) = %v %v, want %v", parent, weight, got, set, mis)
+		}
+		if got, set := SolveTreeMinVertexCover(tree, weight); got != cover || !isVertexCover(tree, set, weight, got) {
+			t.Fatalf("SolveTreeMinVertexCover(%v, %v) = %v %v, want %v", parent, weight, got, set, cover)
+		}
+		dist := treeDistances(tree)
+		diameter := 0
+		for u := range dist {
+			sum := 0
+			for v := range dist[u] {
+				sum += dist[u][v]
+				if dist[u][v] > diameter {
+					diameter = dist[u][v]
+				}
+			}
+			if got := SolveTreeDistanceSums(tree); got[u] != sum {
+				t.Fatalf("SolveTreeDistanceSums(%v) = %v, want %v at %v", parent, got, sum, u)
+			}
+		}
+		if got, path := SolveTreeDiameter(tree); got != diameter || !isTreePath(tree, path, got) {
+			t.Fatalf("SolveTreeDiameter(%v) = %v %v, want %v", parent, got, path, diameter)
+		}
+	}
+}
+
+func TestTreeDPDegenerateBST(t *testing.T) {
+	bst := binarysearchtree.NewTree()
+	if tree, pairs := NewRootedTreeFromBST(bst); len(pairs) != 0 || SolveTreeDistanceSums(tree) == nil {
+		t.Fatal("empty binary search tree should give an empty rooted tree")
+	}
+	// sorted inserts give a path, which would overflow the stack of a recursive traversal if it was long enough
+	n := 10000
+	for i := 0; i < n; i++ {
+		bst.Insert([]byte(fmt.Sprintf("%08d", i)), nil)
+	}
+	tree, pairs := NewRootedTreeFromBST(bst)
+	if len(pairs) != n || tree.Root != 0 {
+		t.Fatalf("NewRootedTreeFromBST() has %v vertices and root %v", len(pairs), tree.Root)
+	}
+	if got, _ := SolveTreeMaxIndependentSet(tree, nil); got != n/2 {
+		t.Errorf("SolveTreeMaxIndependentSet() = %v, want %v", got, n/2)
+	}
+	if got, _ := SolveTreeMinVertexCover(tree, nil); got != n/2 {
+		t.Errorf("SolveTreeMinVertexCover() = %v, want %v", got, n/2)
+	}
+	if got, path := SolveTreeDiameter(tree); got != n-1 || len(path) != n {
+		t.Errorf("SolveTreeDiameter() = %v, want %v", got, n-1)
+	}
+	if got := SolveTreeDistanceSums(tree); got[0] != n*(n-1)/2 || got[n-1] != n*(n-1)/2 {
+		t.Errorf("SolveTreeDistanceSums() = %v ... %v, want %v at both ends", got[0], got[n-1], n*(n-1)/2)
+	}
+}
+
+func totalWeight(set, weight []int) int {
+	total := 0
+	for _, v := range set {
+		total += weightOf(weight, v)
+	}
+	return total
+}
+
+func isIndependentSet(tree *RootedTree, set, weight []int, total int) bool {
+	in := make(map[int]bool)
+	for _, v := range set {
+		in[v] = true
+	}
+	for v, p := range tree.Parent {
+		if p != -1 && in[v] && in[p] {
+			return false
+		}
+	}
+	return totalWeight(set, weight) == total
+}
+
+func isVertexCover(tree *RootedTree, cover, weight []int, total int) bool {
+	in := make(map[int]bool)
+	for _, v := range cover {
+		in[v] = true
+	}
+	for v, p := range tree.Parent {
+		if p != -1 && !in[v] && !in[p] {
+			return false
+		}
+	}
+	return totalWeight(cover, weight) == total
+}
+
+func isTreePath(tree *RootedTree, path []int, length int) bool {
+	if len(path) != length+1 {
+		return false
+	}
+	seen := make(map[int]bool)
+	for i, v := range path {
+		if seen[v] {
+			return false
+		}
+		seen[v] = true
+		if i > 0 && tree.Parent[v] != path[i-1] && tree.Parent[path[i-1]] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// bruteForceTreeSets tries every subset of vertices
+func bruteForceTreeSets(tree *RootedTree, weight []int) (mis, cover int) {
+	n := len(tree.Parent)
+	cover = -1
+	for s := 0; s < 1<<uint(n); s++ {
+		independent, covering, total := true, true, 0
+		for v, p := range tree.Parent {
+			in := s&(1<<uint(v)) != 0
+			if in {
+				total += weight[v]
+			}
+			if p == -1 {
+				continue
+			}
+			parentIn := s&(1<<uint(p)) != 0
+			independent = independent && !(in && parentIn)
+			covering = covering && (in || parentIn)
+		}
+		if independent && total > mis {
+			mis = total
+		}
+		if covering && (cover == -1 || total < cover) {
+			cover = total
+		}
+	}
+	return mis, cover
+}
+
+// treeDistances runs a breadth first search from every vertex
+func treeDistances(tree *RootedTree) [][]int {
+	n := len(tree.Parent)
+	adj := make([][]int, n)
+	for v, p := range tree.Parent {
+		if p != -1 {
+			adj[v] = append(adj[v], p)
+			adj[p] = append(adj[p], v)
+		}
+	}
+	dist := make([][]int, n)
+	for s := range dist {
+		dist[s] = make([]int, n)
+		for v := range dist[s] {
+			dist[s][v] = -1
+		}
+		dist[s][s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, v := range adj[u] {
+				if dist[s][v] == -1 {
+					dist[s][v] = dist[s][u] + 1
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+	return dist
+}
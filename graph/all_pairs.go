@@ -0,0 +1,141 @@
+package graph
+
+// AllPairsShortestPaths are the shortest paths between every pair of vertices on the edge weights.
+// Matrices are indexed by vertex id in both dimensions, rows and columns of removed vertices are kept so ids stay valid.
+type AllPairsShortestPaths struct {
+	Distance [][]int // Distance[u][v] is the total weight of a shortest path from u to v, Unreachable if there is no path
+	Parent   [][]int // Parent[u][v] is the vertex before v on that path, -1 if u == v or v is unreachable from u
+}
+
+func newAllPairsShortestPaths(n int) *AllPairsShortestPaths {
+	p := &AllPairsShortestPaths{
+		Distance: make([][]int, n),
+		Parent:   make([][]int, n),
+	}
+	for u := range p.Distance {
+		p.Distance[u] = make([]int, n)
+		p.Parent[u] = make([]int, n)
+		for v := range p.Distance[u] {
+			p.Distance[u][v] = Unreachable
+			p.Parent[u][v] = -1
+		}
+	}
+	return p
+}
+
+// Path returns the vertices on a shortest path from u to v, both ends included.
+// Returns nil if v is unreachable from u.
+func (p *AllPairsShortestPaths) Path(u, v int) []int {
+	if u < 0 || u >= len(p.Distance) || v < 0 || v >= len(p.Distance) || p.Distance[u][v] == Unreachable {
+		return nil
+	}
+	path := make([]int, 0)
+	for w := v; w != -1; w = p.Parent[u][w] {
+		path = append(path, w)
+	}
+	for k, l := 0, len(path)-1; k < l; k, l = k+1, l-1 {
+		path[k], path[l] = path[l], path[k]
+	}
+	return path
+}
+
+// FloydWarshall finds the shortest paths between every pair of vertices in O(n^3), which suits dense graphs.
+// After the k'th round Distance[u][v] is the shortest path from u to v whose inner vertices are less than k,
+// i.e. d(u, v) = min(d(u, v), d(u, k) + d(k, v)). Edge weights may be negative.
+// Returns ErrNegativeCycle if the graph has a negative cycle, in which case a distance from a vertex to itself becomes negative.
+func (g *adjacencyList) FloydWarshall() (*AllPairsShortestPaths, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	n := len(g.vertices)
+	p := newAllPairsShortestPaths(n)
+	for _, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		p.Distance[v.id][v.id] = 0
+		for e := v.edges; e != nil; e = e.next {
+			if e.weight < p.Distance[v.id][e.to] {
+				p.Distance[v.id][e.to] = e.weight
+				p.Parent[v.id][e.to] = v.id
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		if g.vertices[k] == nil {
+			continue
+		}
+		for u := 0; u < n; u++ {
+			if p.Distance[u][k] == Unreachable {
+				continue
+			}
+			for v := 0; v < n; v++ {
+				if p.Distance[k][v] == Unreachable {
+					continue
+				}
+				if d := p.Distance[u][k] + p.Distance[k][v]; d < p.Distance[u][v] {
+					p.Distance[u][v] = d
+					p.Parent[u][v] = p.Parent[k][v]
+				}
+			}
+			if p.Distance[u][u] < 0 {
+				return nil, ErrNegativeCycle
+			}
+		}
+	}
+	return p, nil
+}
+
+// Johnson finds the shortest paths between every pair of vertices in O(nm log n), which suits sparse graphs.
+// Edge weights may be negative, they are reweighted to w(u, v) + h(u) - h(v) >= 0 by the potentials h that Bellman-Ford finds
+// from a new vertex with an edge of weight 0 to every vertex. The reweighting changes the weight of every path from u to v
+// by h(u) - h(v), so shortest paths are the same and Dijkstra finds them from every vertex.
+// Returns ErrNegativeCycle if the graph has a negative cycle.
+func (g *adjacencyList) Johnson() (*AllPairsShortestPaths, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	h, ok := g.potentialsSafe()
+	if !ok {
+		return nil, ErrNegativeCycle
+	}
+	p := newAllPairsShortestPaths(len(g.vertices))
+	reweighted := func(v int, e *edge) int { return e.weight + h[v] - h[e.to] }
+	for _, u := range g.vertices {
+		if u == nil {
+			continue
+		}
+		s := g.dijkstraSafe(u.id, reweighted)
+		for v, d := range s.Distance {
+			if d != Unreachable {
+				p.Distance[u.id][v] = d - h[u.id] + h[v]
+			}
+		}
+		p.Parent[u.id] = s.Parent
+	}
+	return p, nil
+}
+
+// potentialsSafe returns the distances from a new vertex with an edge of weight 0 to every vertex,
+// i.e. every distance starts at 0, and false if there is a negative cycle.
+// it's assumed that the read lock is held
+func (g *adjacencyList) potentialsSafe() ([]int, bool) {
+	h := make([]int, len(g.vertices))
+	// the new vertex makes n+1 vertices, so n rounds are enough without a negative cycle
+	for round := 0; round <= len(g.vertices); round++ {
+		changed := false
+		for _, v := range g.vertices {
+			if v == nil {
+				continue
+			}
+			for e := v.edges; e != nil; e = e.next {
+				if h[v.id]+e.weight < h[e.to] {
+					h[e.to] = h[v.id] + e.weight
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return h, true
+		}
+	}
+	return nil, false
+}
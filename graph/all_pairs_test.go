@@ -0,0 +1,159 @@
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"algorithms/graph"
+)
+
+const inf = graph.Unreachable
+
+var allPairsSolvers = map[string]func(g searchableGraph) (*graph.AllPairsShortestPaths, error){
+	"FloydWarshall()": func(g searchableGraph) (*graph.AllPairsShortestPaths, error) { return g.FloydWarshall() },
+	"Johnson()":       func(g searchableGraph) (*graph.AllPairsShortestPaths, error) { return g.Johnson() },
+}
+
+// checkAllPairs checks every row of the matrices as the shortest paths from a single source
+func checkAllPairs(t *testing.T, g searchableGraph, p *graph.AllPairsShortestPaths) {
+	for u := range p.Distance {
+		if p.Distance[u][u] == inf {
+			continue
+		}
+		checkShortestPaths(t, g, &graph.ShortestPaths{Source: u, Distance: p.Distance[u], Parent: p.Parent[u]})
+		for v := range p.Distance[u] {
+			if fmt.Sprint(p.Path(u, v)) != fmt.Sprint((&graph.ShortestPaths{Source: u, Distance: p.Distance[u], Parent: p.Parent[u]}).PathTo(v)) {
+				t.Fatalf("Path(%v, %v) = %v does not follow the parents", u, v, p.Path(u, v))
+			}
+		}
+	}
+}
+
+func TestGraph_AllPairsShortestPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       searchableGraph
+		removed []int
+		want    [][]int
+		err     error
+	}{
+		{
+			name: "directed with negative weights",
+			// the example in CLRS for Floyd-Warshall with vertices 1 to 5 renamed to 0 to 4
+			g: newWeightedGraph(graph.NewDirectedGraph(), 5, []weightedEdge{
+				{0, 1, 3}, {0, 2, 8}, {0, 4, -4}, {1, 3, 1}, {1, 4, 7}, {2, 1, 4}, {3, 0, 2}, {3, 2, -5}, {4, 3, 6},
+			}),
+			want: [][]int{
+				{0, 1, -3, 2, -4},
+				{3, 0, -4, 1, -1},
+				{7, 4, 0, 5, 3},
+				{2, -1, -5, 0, -2},
+				{8, 5, 1, 6, 0},
+			},
+		},
+		{
+			name: "undirected disconnected",
+			g:    newWeightedGraph(graph.NewUndirectedGraph(), 4, []weightedEdge{{0, 1, 2}, {2, 3, 5}}),
+			want: [][]int{
+				{0, 2, inf, inf},
+				{2, 0, inf, inf},
+				{inf, inf, 0, 5},
+				{inf, inf, 5, 0},
+			},
+		},
+		{
+			name:    "removed vertex",
+			g:       newWeightedGraph(graph.NewUndirectedGraph(), 4, []weightedEdge{{0, 1, 1}, {1, 2, 1}, {0, 2, 5}, {2, 3, 1}}),
+			removed: []int{1},
+			want: [][]int{
+				{0, inf, 5, 6},
+				{inf, inf, inf, inf},
+				{5, inf, 0, 1},
+				{6, inf, 1, 0},
+			},
+		},
+		{
+			name: "negative cycle",
+			g:    newWeightedGraph(graph.NewDirectedGraph(), 4, []weightedEdge{{0, 1, 1}, {2, 3, -2}, {3, 2, 1}}),
+			err:  graph.ErrNegativeCycle,
+		},
+		{
+			name: "negative undirected edge",
+			g:    newWeightedGraph(graph.NewUndirectedGraph(), 2, []weightedEdge{{0, 1, -1}}),
+			err:  graph.ErrNegativeCycle,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range tt.removed {
+				tt.g.RemoveVertex(v)
+			}
+			for name, solve := range allPairsSolvers {
+				got, err := solve(tt.g)
+				if err != tt.err {
+					t.Fatalf("%v error = %v, want %v", name, err, tt.err)
+				}
+				if err != nil {
+					continue
+				}
+				if fmt.Sprint(got.Distance) != fmt.Sprint(tt.want) {
+					t.Errorf("%v distance = %v, want %v", name, got.Distance, tt.want)
+				}
+				checkAllPairs(t, tt.g, got)
+			}
+		})
+	}
+}
+
+func TestGraph_AllPairsShortestPathsRandom(t *testing.T) {
+	for c := 0; c < 200; c++ {
+		n := 1 + rand.Intn(15)
+		var g searchableGraph = graph.NewDirectedGraph()
+		if c%4 == 0 {
+			g = graph.NewUndirectedGraph()
+		}
+		for i := 0; i < n; i++ {
+			g.AddVertex(fmt.Sprintf("vertex_%v", i))
+		}
+		// undirected graphs get non-negative weights, otherwise every negative edge is a negative cycle
+		low := -3
+		if c%4 == 0 {
+			low = 0
+		}
+		for i := rand.Intn(3 * n); i > 0; i-- {
+			g.AddEdge(rand.Intn(n), rand.Intn(n), low+rand.Intn(20))
+		}
+		if rand.Intn(3) == 0 {
+			g.RemoveVertex(rand.Intn(n))
+		}
+		fw, fwErr := g.FloydWarshall()
+		johnson, johnsonErr := g.Johnson()
+		if fwErr != johnsonErr {
+			t.Fatalf("FloydWarshall() error = %v, Johnson() error = %v", fwErr, johnsonErr)
+		}
+		negativeCycle := false
+		for _, v := range g.Vertices() {
+			if _, _, err := g.BellmanFord(v.ID); err == graph.ErrNegativeCycle {
+				negativeCycle = true
+			}
+		}
+		if negativeCycle != (fwErr == graph.ErrNegativeCycle) {
+			t.Fatalf("FloydWarshall() error = %v, BellmanFord() found a negative cycle %v", fwErr, negativeCycle)
+		}
+		if fwErr != nil {
+			continue
+		}
+		if fmt.Sprint(fw.Distance) != fmt.Sprint(johnson.Distance) {
+			t.Fatalf("FloydWarshall() = %v, Johnson() = %v", fw.Distance, johnson.Distance)
+		}
+		for _, v := range g.Vertices() {
+			single, _, _ := g.BellmanFord(v.ID)
+			if fmt.Sprint(single.Distance) != fmt.Sprint(fw.Distance[v.ID]) {
+				t.Fatalf("FloydWarshall() row %v = %v, BellmanFord() = %v", v.ID, fw.Distance[v.ID], single.Distance)
+			}
+		}
+		checkAllPairs(t, g, fw)
+		checkAllPairs(t, g, johnson)
+	}
+}
@@ -0,0 +1,76 @@
+package graph
+
+import "algorithms/datastructures/minpriorityqueue"
+
+// Heuristic estimates the total weight of a shortest path from the vertex with id vertexID to the target of a search.
+// It is admissible if it never overestimates, and consistent if h(u) <= w(u, v) + h(v) for every edge.
+type Heuristic func(vertexID int) int
+
+// AStarResult is the result of an A* search.
+type AStarResult struct {
+	Path     []int // vertices from start to target, both ends included
+	Cost     int   // total weight of the path
+	Expanded int   // number of vertices taken from the open set, i.e. whose edges were explored
+}
+
+// AStar finds a shortest path from start to target on the edge weights, exploring vertices in increasing order of
+// f(v) = g(v) + h(v), where g(v) is the weight of the best path to v found so far and h(v) is the heuristic.
+// A nil heuristic is 0 everywhere, which makes it Dijkstra's algorithm that stops at the target.
+// The path is shortest if h is admissible. A vertex may be expanded more than once if h is not consistent.
+// Returns ErrVertexNotFound if start or target is not a vertex of the graph, ErrNegativeWeight if any edge has a negative weight
+// and ErrNoPath if target can not be reached, in which case the result still has the number of expanded vertices.
+func (g *adjacencyList) AStar(start, target int, h Heuristic) (*AStarResult, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(start) || !g.validVertexSafe(target) {
+		return nil, ErrVertexNotFound
+	}
+	if h == nil {
+		h = func(int) int { return 0 }
+	}
+	for _, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		for e := v.edges; e != nil; e = e.next {
+			if e.weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+		}
+	}
+	n := len(g.vertices)
+	cost := make([]int, n)
+	parent := make([]int, n)
+	for v := range cost {
+		cost[v] = Unreachable
+		parent[v] = -1
+	}
+	r := &AStarResult{}
+	open := minpriorityqueue.NewMinPriorityQueue(n)
+	cost[start] = 0
+	open.Insert(start, h(start))
+	for open.Len() > 0 {
+		v, _ := open.ExtractMinimum()
+		r.Expanded++
+		if v == target {
+			r.Cost = cost[v]
+			r.Path = (&ShortestPaths{Source: start, Distance: cost, Parent: parent}).PathTo(target)
+			return r, nil
+		}
+		for e := g.vertices[v].edges; e != nil; e = e.next {
+			c := cost[v] + e.weight
+			if c >= cost[e.to] {
+				continue
+			}
+			cost[e.to] = c
+			parent[e.to] = v
+			// a vertex that was already expanded is opened again
+			if open.Contains(e.to) {
+				open.DecreaseKey(e.to, c+h(e.to))
+			} else {
+				open.Insert(e.to, c+h(e.to))
+			}
+		}
+	}
+	return r, ErrNoPath
+}
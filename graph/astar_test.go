@@ -0,0 +1,250 @@
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"algorithms/graph"
+)
+
+// newGrid returns a width x height grid where the vertex at x, y has id y*width + x and is connected to its 4 neighbours.
+// weight gives the weight of the edge between two neighbours, cells in walls are removed.
+func newGrid(width, height int, weight func(a, b int) int, walls []int) *graph.UndirectedGraph {
+	g := graph.NewUndirectedGraph()
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			g.AddVertex(fmt.Sprintf("%v,%v", x, y))
+		}
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := y*width + x
+			if x+1 < width {
+				g.AddEdge(v, v+1, weight(v, v+1))
+			}
+			if y+1 < height {
+				g.AddEdge(v, v+width, weight(v, v+width))
+			}
+		}
+	}
+	for _, v := range walls {
+		g.RemoveVertex(v)
+	}
+	return g
+}
+
+// manhattan returns the manhattan distance heuristic to target on a grid, it is admissible if every weight is at least 1
+func manhattan(width, target int) graph.Heuristic {
+	abs := func(a int) int {
+		if a < 0 {
+			return -a
+		}
+		return a
+	}
+	return func(v int) int {
+		return abs(v%width-target%width) + abs(v/width-target/width)
+	}
+}
+
+// pathWeight returns the total weight of the path and false if it uses a missing edge
+func pathWeight(g graph.Graph, path []int) (int, bool) {
+	total := 0
+	for i := 1; i < len(path); i++ {
+		found := false
+		for _, e := range g.VertexEdges(path[i-1]) {
+			if e.To == path[i] {
+				total += e.Weight
+				found = true
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return total, len(path) > 0
+}
+
+func unitWeight(a, b int) int {
+	return 1
+}
+
+func TestGraph_AStar(t *testing.T) {
+	type args struct {
+		start, target int
+		h             graph.Heuristic
+	}
+	tests := []struct {
+		name     string
+		g        searchableGraph
+		args     args
+		want     []int
+		cost     int
+		expanded int
+		err      error
+	}{
+		{
+			name:     "straight line",
+			g:        newGrid(5, 5, unitWeight, nil),
+			args:     args{0, 4, manhattan(5, 4)},
+			want:     []int{0, 1, 2, 3, 4},
+			cost:     4,
+			expanded: 5,
+		},
+		{
+			name: "around a wall",
+			// 0 1 2
+			// # # 5
+			// 6 7 8
+			g:        newGrid(3, 3, unitWeight, []int{3, 4}),
+			args:     args{0, 6, manhattan(3, 6)},
+			want:     []int{0, 1, 2, 5, 8, 7, 6},
+			cost:     6,
+			expanded: 7,
+		},
+		{
+			name:     "same vertex",
+			g:        newGrid(3, 3, unitWeight, nil),
+			args:     args{4, 4, manhattan(3, 4)},
+			want:     []int{4},
+			cost:     0,
+			expanded: 1,
+		},
+		{
+			name:     "walled off",
+			g:        newGrid(3, 3, unitWeight, []int{1, 3, 4}),
+			args:     args{0, 8, manhattan(3, 8)},
+			expanded: 1,
+			err:      graph.ErrNoPath,
+		},
+		{
+			name: "removed target",
+			g:    newGrid(3, 3, unitWeight, []int{8}),
+			args: args{0, 8, nil},
+			err:  graph.ErrVertexNotFound,
+		},
+		{
+			name: "negative weight",
+			g:    newWeightedGraph(graph.NewDirectedGraph(), 3, []weightedEdge{{0, 1, 1}, {1, 2, -1}}),
+			args: args{0, 2, nil},
+			err:  graph.ErrNegativeWeight,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.AStar(tt.args.start, tt.args.target, tt.args.h)
+			if err != tt.err {
+				t.Fatalf("AStar() error = %v, want %v", err, tt.err)
+			}
+			if got == nil {
+				return
+			}
+			if fmt.Sprint(got.Path) != fmt.Sprint(tt.want) || got.Cost != tt.cost || got.Expanded != tt.expanded {
+				t.Errorf("AStar() = %v %v %v, want %v %v %v", got.Path, got.Cost, got.Expanded, tt.want, tt.cost, tt.expanded)
+			}
+		})
+	}
+}
+
+func TestGraph_AStarZeroHeuristic(t *testing.T) {
+	// on an open grid the zero heuristic expands every vertex that is closer than the target
+	g := newGrid(20, 20, unitWeight, nil)
+	start, target := 0, 19
+	zero, err := g.AStar(start, target, nil)
+	if err != nil {
+		t.Fatalf("AStar() error = %v", err)
+	}
+	d, _ := g.Dijkstra(start)
+	if zero.Cost != d.Distance[target] {
+		t.Errorf("AStar() cost = %v, Dijkstra() = %v", zero.Cost, d.Distance[target])
+	}
+	closer := 0
+	for _, dist := range d.Distance {
+		if dist < d.Distance[target] {
+			closer++
+		}
+	}
+	if zero.Expanded <= closer {
+		t.Errorf("AStar() expanded %v vertices, want more than %v", zero.Expanded, closer)
+	}
+	guided, err := g.AStar(start, target, manhattan(20, target))
+	if err != nil || guided.Cost != zero.Cost {
+		t.Fatalf("AStar() = %v, %v, want cost %v", guided, err, zero.Cost)
+	}
+	if guided.Expanded != d.Distance[target]+1 {
+		t.Errorf("AStar() with manhattan distance expanded %v vertices, want %v", guided.Expanded, d.Distance[target]+1)
+	}
+}
+
+func TestGraph_AStarRandom(t *testing.T) {
+	zeroExpanded, guidedExpanded := 0, 0
+	for c := 0; c < 100; c++ {
+		width, height := 2+rand.Intn(15), 2+rand.Intn(15)
+		walls := make([]int, 0)
+		for v := 0; v < width*height; v++ {
+			if rand.Intn(5) == 0 {
+				walls = append(walls, v)
+			}
+		}
+		g := newGrid(width, height, func(a, b int) int { return 1 + rand.Intn(9) }, walls)
+		vertices := g.Vertices()
+		if vertices == nil {
+			continue
+		}
+		start := vertices[rand.Intn(len(vertices))].ID
+		target := vertices[rand.Intn(len(vertices))].ID
+		// the distances to the target give a perfect heuristic, a random fraction of it is admissible but not consistent
+		exact, _ := g.Dijkstra(target)
+		fraction := make([]int, len(exact.Distance))
+		for v, d := range exact.Distance {
+			if d != graph.Unreachable {
+				fraction[v] = rand.Intn(d + 1)
+			}
+		}
+		heuristics := map[string]graph.Heuristic{
+			"zero":      nil,
+			"manhattan": manhattan(width, target),
+			"random": func(v int) int {
+				return fraction[v]
+			},
+		}
+		results := make(map[string]*graph.AStarResult)
+		for name, h := range heuristics {
+			got, err := g.AStar(start, target, h)
+			if exact.Distance[start] == graph.Unreachable {
+				if err != graph.ErrNoPath {
+					t.Fatalf("AStar() with %v heuristic error = %v, want %v", name, err, graph.ErrNoPath)
+				}
+				continue
+			}
+			if err != nil || got.Cost != exact.Distance[start] {
+				t.Fatalf("AStar() with %v heuristic = %v, %v, want cost %v", name, got, err, exact.Distance[start])
+			}
+			if w, ok := pathWeight(g, got.Path); !ok || w != got.Cost || got.Path[0] != start || got.Path[len(got.Path)-1] != target {
+				t.Fatalf("AStar() with %v heuristic path = %v is not a path of cost %v", name, got.Path, got.Cost)
+			}
+			results[name] = got
+		}
+		if len(results) > 0 {
+			zeroExpanded += results["zero"].Expanded
+			guidedExpanded += results["manhattan"].Expanded
+		}
+	}
+	if guidedExpanded >= zeroExpanded {
+		t.Errorf("manhattan heuristic expanded %v vertices, zero heuristic %v", guidedExpanded, zeroExpanded)
+	}
+}
+
+// Grid example. Manhattan distance never overestimates on a grid with unit weights,
+// so A* finds a shortest path while expanding only the vertices towards the target.
+func ExampleUndirectedGraph_AStar_grid() {
+	// . . . . .
+	// . # # # .
+	// S . . # T
+	width := 5
+	g := newGrid(width, 3, unitWeight, []int{6, 7, 8, 13})
+	r, _ := g.AStar(10, 14, manhattan(width, 14))
+	fmt.Println(r.Path, r.Cost)
+	// Output:
+	// [10 5 0 1 2 3 4 9 14] 8
+}
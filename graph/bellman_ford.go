@@ -0,0 +1,107 @@
+package graph
+
+// BellmanFord finds the shortest paths from the vertex with id source to all other vertices on the edge weights,
+// which may be negative. Every edge is relaxed in rounds until no distance changes, n-1 rounds are enough unless
+// a negative cycle can be reached from the source, in which case a distance still changes in the n'th round.
+// Every edge of an undirected graph goes both ways, so an undirected edge with a negative weight is a negative cycle.
+// Returns ErrVertexNotFound if source is not a vertex of the graph, and ErrNegativeCycle with the vertices of a negative cycle
+// in the order of its edges, where the first vertex is repeated at the end.
+func (g *adjacencyList) BellmanFord(source int) (*ShortestPaths, []int, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(source) {
+		return nil, nil, ErrVertexNotFound
+	}
+	n := len(g.vertices)
+	p := newShortestPaths(source, n)
+	for round := 1; round <= n; round++ {
+		changed := -1
+		for _, v := range g.vertices {
+			if v == nil || p.Distance[v.id] == Unreachable {
+				continue
+			}
+			for e := v.edges; e != nil; e = e.next {
+				if p.Distance[v.id]+e.weight < p.Distance[e.to] {
+					p.Distance[e.to] = p.Distance[v.id] + e.weight
+					p.Parent[e.to] = v.id
+					changed = e.to
+				}
+			}
+		}
+		if changed == -1 {
+			return p, nil, nil
+		}
+		if round == n {
+			cycle, _ := parentCycle(p.Parent, changed)
+			return nil, cycle, ErrNegativeCycle
+		}
+	}
+	return p, nil, nil
+}
+
+// SPFA (shortest path faster algorithm) finds the same shortest paths as BellmanFord, but only relaxes the edges of
+// the vertices whose distances changed, which are kept in a queue. A path of n or more edges means there is a cycle
+// on the parents, which is then a negative cycle. Returns the same errors as BellmanFord.
+func (g *adjacencyList) SPFA(source int) (*ShortestPaths, []int, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(source) {
+		return nil, nil, ErrVertexNotFound
+	}
+	n := len(g.vertices)
+	p := newShortestPaths(source, n)
+	// length[v] is the number of edges on the path to v when it was found, the parents may have changed since
+	length := make([]int, n)
+	queued := make([]bool, n)
+	q := newQueue()
+	q.enqueue(source)
+	queued[source] = true
+	for {
+		v, status := q.dequeue()
+		if status == -1 {
+			break
+		}
+		queued[v] = false
+		for e := g.vertices[v].edges; e != nil; e = e.next {
+			if p.Distance[v]+e.weight >= p.Distance[e.to] {
+				continue
+			}
+			p.Distance[e.to] = p.Distance[v] + e.weight
+			p.Parent[e.to] = v
+			if length[e.to] = length[v] + 1; length[e.to] >= n {
+				cycle, edges := parentCycle(p.Parent, e.to)
+				if cycle != nil {
+					return nil, cycle, ErrNegativeCycle
+				}
+				length[e.to] = edges
+			}
+			if !queued[e.to] {
+				q.enqueue(e.to)
+				queued[e.to] = true
+			}
+		}
+	}
+	return p, nil, nil
+}
+
+// parentCycle follows the parents from v. Returns the cycle that is reached in the order of its edges,
+// with the first vertex repeated at the end, or nil and the number of edges from the root to v if there is no cycle.
+func parentCycle(parent []int, v int) ([]int, int) {
+	// a path without a cycle has less than len(parent) edges
+	u := v
+	for i := 0; i < len(parent); i++ {
+		if parent[u] == -1 {
+			return nil, i
+		}
+		u = parent[u]
+	}
+	cycle := []int{u}
+	for w := parent[u]; w != u; w = parent[w] {
+		cycle = append(cycle, w)
+	}
+	cycle = append(cycle, u)
+	for k, l := 0, len(cycle)-1; k < l; k, l = k+1, l-1 {
+		cycle[k], cycle[l] = cycle[l], cycle[k]
+	}
+	return cycle, len(parent)
+}
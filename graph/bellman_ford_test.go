@@ -0,0 +1,173 @@
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"algorithms/graph"
+)
+
+type shortestPathsFunc func(g searchableGraph, source int) (*graph.ShortestPaths, []int, error)
+
+var negativeWeightSolvers = map[string]shortestPathsFunc{
+	"BellmanFord()": func(g searchableGraph, source int) (*graph.ShortestPaths, []int, error) { return g.BellmanFord(source) },
+	"SPFA()":        func(g searchableGraph, source int) (*graph.ShortestPaths, []int, error) { return g.SPFA(source) },
+}
+
+// checkNegativeCycle checks that cycle is a closed walk over the edges of the graph with distinct vertices and a negative weight
+func checkNegativeCycle(t *testing.T, name string, g graph.Graph, cycle []int) {
+	if len(cycle) < 3 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatalf("%v cycle = %v is not closed", name, cycle)
+	}
+	seen := make(map[int]bool)
+	total := 0
+	for i := 1; i < len(cycle); i++ {
+		if seen[cycle[i]] {
+			t.Fatalf("%v cycle = %v repeats %v", name, cycle, cycle[i])
+		}
+		seen[cycle[i]] = true
+		found := false
+		for _, e := range g.VertexEdges(cycle[i-1]) {
+			if e.To == cycle[i] {
+				total += e.Weight
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("%v cycle = %v uses a missing edge %v - %v", name, cycle, cycle[i-1], cycle[i])
+		}
+	}
+	if total >= 0 {
+		t.Fatalf("%v cycle = %v weighs %v", name, cycle, total)
+	}
+}
+
+func TestGraph_BellmanFord(t *testing.T) {
+	// s = 0, t = 1, x = 2, y = 3, z = 4 from the example in CLRS
+	clrs := []weightedEdge{{0, 1, 6}, {0, 3, 7}, {1, 2, 5}, {1, 3, 8}, {1, 4, -4}, {2, 1, -2}, {3, 2, -3}, {3, 4, 9}, {4, 0, 2}, {4, 2, 7}}
+	tests := []struct {
+		name   string
+		g      searchableGraph
+		source int
+		want   []int
+		err    error
+	}{
+		{
+			name:   "negative weights",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 5, clrs),
+			source: 0,
+			want:   []int{0, 2, 4, 7, -2},
+		},
+		{
+			name:   "non-negative undirected",
+			g:      newWeightedGraph(graph.NewUndirectedGraph(), 4, []weightedEdge{{0, 1, 4}, {1, 2, 1}, {0, 2, 6}, {2, 3, 0}}),
+			source: 3,
+			want:   []int{5, 1, 0, 0},
+		},
+		{
+			name:   "negative cycle",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 5, []weightedEdge{{0, 1, 1}, {1, 2, -1}, {2, 3, -1}, {3, 1, 1}, {3, 4, 10}}),
+			source: 0,
+			err:    graph.ErrNegativeCycle,
+		},
+		{
+			name:   "negative cycle through the source",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 2, []weightedEdge{{0, 1, 1}, {1, 0, -2}}),
+			source: 0,
+			err:    graph.ErrNegativeCycle,
+		},
+		{
+			name:   "unreachable negative cycle",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 5, []weightedEdge{{0, 1, 2}, {1, 4, -1}, {2, 3, -5}, {3, 2, 1}, {3, 1, 0}}),
+			source: 0,
+			want:   []int{0, 2, graph.Unreachable, graph.Unreachable, 1},
+		},
+		{
+			name:   "negative undirected edge",
+			g:      newWeightedGraph(graph.NewUndirectedGraph(), 3, []weightedEdge{{0, 1, 2}, {1, 2, -1}}),
+			source: 0,
+			err:    graph.ErrNegativeCycle,
+		},
+		{
+			name:   "invalid source",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 2, []weightedEdge{{0, 1, -1}}),
+			source: -1,
+			err:    graph.ErrVertexNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, solve := range negativeWeightSolvers {
+				got, cycle, err := solve(tt.g, tt.source)
+				if err != tt.err {
+					t.Fatalf("%v error = %v, want %v", name, err, tt.err)
+				}
+				if err == graph.ErrNegativeCycle {
+					checkNegativeCycle(t, name, tt.g, cycle)
+					continue
+				}
+				if err != nil {
+					continue
+				}
+				if fmt.Sprint(got.Distance) != fmt.Sprint(tt.want) {
+					t.Errorf("%v distance = %v, want %v", name, got.Distance, tt.want)
+				}
+				checkShortestPaths(t, tt.g, got)
+			}
+		})
+	}
+}
+
+func TestGraph_BellmanFordRandom(t *testing.T) {
+	cycles := 0
+	for c := 0; c < 300; c++ {
+		n := 1 + rand.Intn(20)
+		var g searchableGraph = graph.NewDirectedGraph()
+		if c%10 == 0 {
+			g = graph.NewUndirectedGraph()
+		}
+		for i := 0; i < n; i++ {
+			g.AddVertex(fmt.Sprintf("vertex_%v", i))
+		}
+		for i := rand.Intn(3 * n); i > 0; i-- {
+			g.AddEdge(rand.Intn(n), rand.Intn(n), rand.Intn(30)-5)
+		}
+		if rand.Intn(4) == 0 {
+			g.RemoveVertex(rand.Intn(n))
+		}
+		vertices := g.Vertices()
+		if vertices == nil {
+			continue
+		}
+		source := vertices[rand.Intn(len(vertices))].ID
+		want, negativeCycle := bellmanFord(g, source)
+		if negativeCycle {
+			cycles++
+		}
+		reachable := g.BFSTree(source)
+		for name, solve := range negativeWeightSolvers {
+			got, cycle, err := solve(g, source)
+			if negativeCycle {
+				if err != graph.ErrNegativeCycle {
+					t.Fatalf("%v error = %v, want %v", name, err, graph.ErrNegativeCycle)
+				}
+				checkNegativeCycle(t, name, g, cycle)
+				if reachable.Distance[cycle[0]] == -1 {
+					t.Fatalf("%v cycle = %v can not be reached from %v", name, cycle, source)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%v error = %v", name, err)
+			}
+			if !sameDistances(got.Distance, want) {
+				t.Fatalf("%v distance = %v, want %v", name, got.Distance, want)
+			}
+			checkShortestPaths(t, g, got)
+		}
+	}
+	if cycles == 0 {
+		t.Errorf("no random graph had a negative cycle")
+	}
+}
@@ -2,7 +2,7 @@ package graph
 
 // queue is a simple queue implementation using a linked list
 type queue struct {
-	head *node
+	head, tail *node
 }
 type node struct {
 	value int
@@ -11,10 +11,16 @@ type node struct {
 
 // BFS performs a breadth first search in the graph g starting from a vertex whose id is given as input.
 // processVertex function is called with vertex id, vertex name and all the 'outgoing' edges of the vertex once a vertex is encountered
-// processEdge function is called once an edge is traversed
-func (g *UndirectedGraph) BFS(start int, processVertex func(vertexId int, vertexName string, vertexEdges []*Edge), processEdge func(edge *Edge) (terminate bool)) {
+// processEdge function is called once an edge is traversed. In an undirected graph an edge is traversed from the vertex that is processed first,
+// in a directed graph every edge that leaves a processed vertex is traversed.
+func (g *adjacencyList) BFS(start int, processVertex func(vertexId int, vertexName string, vertexEdges []*Edge), processEdge func(edge *Edge) (terminate bool)) {
 	g.mtx.RLock()
 	defer g.mtx.RUnlock()
+	g.bfsSafe(start, processVertex, processEdge)
+}
+
+// bfsSafe is BFS for callers that already hold the lock.
+func (g *adjacencyList) bfsSafe(start int, processVertex func(vertexId int, vertexName string, vertexEdges []*Edge), processEdge func(edge *Edge) (terminate bool)) {
 	// check start validity
 	if start < 0 || start >= len(g.vertices) {
 		return
@@ -49,9 +55,9 @@ func (g *UndirectedGraph) BFS(start int, processVertex func(vertexId int, vertex
 		e = g.vertices[v].edges
 		for e != nil {
 			y = e.to
-			if !processed[y] {
+			if !processed[y] || g.directed {
 				if processEdge != nil {
-					if t = processEdge(&Edge{from: v, to: y, weight: e.weight}); t {
+					if t = processEdge(&Edge{From: v, To: y, Weight: e.weight}); t {
 						return
 					}
 				}
@@ -75,14 +81,11 @@ func (q *queue) enqueue(v int) {
 
 	if q.head == nil {
 		q.head = n
+		q.tail = n
 		return
 	}
-	p := q.head
-
-	for p.next != nil {
-		p = p.next
-	}
-	p.next = n
+	q.tail.next = n
+	q.tail = n
 }
 
 // status is -1 if there is nothing to dequeue
@@ -94,5 +97,93 @@ func (q *queue) dequeue() (value int, status int) {
 	}
 	value = q.head.value
 	q.head = q.head.next
+	if q.head == nil {
+		q.tail = nil
+	}
 	return
 }
+
+// BFSTree is the shortest path tree that is found by a breadth first search. Slices are indexed by vertex id.
+type BFSTree struct {
+	Sources  []int // vertices the search started from
+	Distance []int // Distance[v] is the number of edges on a shortest path from the nearest source to v, -1 if v is unreachable
+	Parent   []int // Parent[v] is the vertex before v on that path, -1 for sources and unreachable vertices
+}
+
+// BFSTree performs a breadth first search starting from the vertex with id start and returns its shortest path tree.
+// Every vertex is unreachable if start is not a vertex of the graph.
+func (g *adjacencyList) BFSTree(start int) *BFSTree {
+	return g.MultiSourceBFSTree([]int{start})
+}
+
+// MultiSourceBFSTree performs a breadth first search that starts from all sources at once,
+// i.e. the distance of a vertex is the distance to its nearest source. Ids that are not vertices of the graph are ignored.
+func (g *adjacencyList) MultiSourceBFSTree(sources []int) *BFSTree {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	return g.multiSourceBFSTreeSafe(sources)
+}
+
+// multiSourceBFSTreeSafe is MultiSourceBFSTree for callers that already hold the lock.
+func (g *adjacencyList) multiSourceBFSTreeSafe(sources []int) *BFSTree {
+	t := &BFSTree{
+		Sources:  make([]int, 0, len(sources)),
+		Distance: make([]int, len(g.vertices)),
+		Parent:   make([]int, len(g.vertices)),
+	}
+	for v := range t.Distance {
+		t.Distance[v] = -1
+		t.Parent[v] = -1
+	}
+	q := newQueue()
+	for _, s := range sources {
+		if !g.validVertexSafe(s) || t.Distance[s] == 0 {
+			continue
+		}
+		t.Sources = append(t.Sources, s)
+		t.Distance[s] = 0
+		q.enqueue(s)
+	}
+	for {
+		v, status := q.dequeue()
+		if status == -1 {
+			break
+		}
+		for e := g.vertices[v].edges; e != nil; e = e.next {
+			if t.Distance[e.to] == -1 {
+				t.Distance[e.to] = t.Distance[v] + 1
+				t.Parent[e.to] = v
+				q.enqueue(e.to)
+			}
+		}
+	}
+	return t
+}
+
+// PathTo returns the vertices on a shortest path from the nearest source to target, both ends included.
+// Returns nil if target is unreachable.
+func (t *BFSTree) PathTo(target int) []int {
+	if target < 0 || target >= len(t.Distance) || t.Distance[target] == -1 {
+		return nil
+	}
+	path := make([]int, t.Distance[target]+1)
+	for i, v := len(path)-1, target; i >= 0; i, v = i-1, t.Parent[v] {
+		path[i] = v
+	}
+	return path
+}
+
+// ShortestPathUnweighted returns the vertices on a path with the fewest edges from start to target, both ends included.
+// Returns ErrVertexNotFound if start or target is not a vertex of the graph and ErrNoPath if target can not be reached.
+func (g *adjacencyList) ShortestPathUnweighted(start, target int) ([]int, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(start) || !g.validVertexSafe(target) {
+		return nil, ErrVertexNotFound
+	}
+	path := g.multiSourceBFSTreeSafe([]int{start}).PathTo(target)
+	if path == nil {
+		return nil, ErrNoPath
+	}
+	return path, nil
+}
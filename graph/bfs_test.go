@@ -1,8 +1,10 @@
-package graph
+package graph_test
 
 import (
 	"fmt"
 	"testing"
+
+	"algorithms/graph"
 )
 
 func TestUndirectedGraph_BFS(t *testing.T) {
@@ -14,8 +16,8 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 		vertexCount   int
 		edges         []edge
 		start         int
-		processVertex func(vertexId int, vertexName string, vertexEdges []*Edge)
-		processEdge   func(edge *Edge) (terminate bool)
+		processVertex func(vertexId int, vertexName string, vertexEdges []*graph.Edge)
+		processEdge   func(edge *graph.Edge) (terminate bool)
 	}
 	tests := []struct {
 		name string
@@ -33,7 +35,7 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 					{3, 4},
 				},
 				start: 0,
-				processVertex: func(id int, name string, edges []*Edge) {
+				processVertex: func(id int, name string, edges []*graph.Edge) {
 					vertices = append(vertices, id)
 				},
 				processEdge: nil,
@@ -51,7 +53,7 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 					{3, 4},
 				},
 				start: 4,
-				processVertex: func(id int, name string, edges []*Edge) {
+				processVertex: func(id int, name string, edges []*graph.Edge) {
 					vertices = append(vertices, id)
 				},
 				processEdge: nil,
@@ -69,7 +71,7 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 					{3, 4},
 				},
 				start: 2,
-				processVertex: func(id int, name string, edges []*Edge) {
+				processVertex: func(id int, name string, edges []*graph.Edge) {
 					vertices = append(vertices, id)
 				},
 				processEdge: nil,
@@ -88,7 +90,7 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 					{0, 5},
 				},
 				start: 0,
-				processVertex: func(id int, name string, edges []*Edge) {
+				processVertex: func(id int, name string, edges []*graph.Edge) {
 					vertices = append(vertices, id)
 				},
 				processEdge: nil,
@@ -109,7 +111,7 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 					{2, 3},
 				},
 				start: 0,
-				processVertex: func(id int, name string, edges []*Edge) {
+				processVertex: func(id int, name string, edges []*graph.Edge) {
 					vertices = append(vertices, id)
 				},
 				processEdge: nil,
@@ -119,7 +121,7 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewUndirectedGraph()
+			g := graph.NewUndirectedGraph()
 			for i := 0; i < tt.args.vertexCount; i++ {
 				g.AddVertex("v for vertex")
 			}
@@ -140,13 +142,155 @@ func TestUndirectedGraph_BFS(t *testing.T) {
 	}
 }
 
+func TestUndirectedGraph_BFSTree(t *testing.T) {
+	type edge struct {
+		a, b int
+	}
+	type want struct {
+		distance, parent []int
+	}
+	tests := []struct {
+		name        string
+		vertexCount int
+		edges       []edge
+		removed     []int
+		sources     []int
+		want        want
+	}{
+		{
+			name:        "path",
+			vertexCount: 4,
+			edges:       []edge{{0, 1}, {1, 2}, {2, 3}},
+			sources:     []int{0},
+			want:        want{[]int{0, 1, 2, 3}, []int{-1, 0, 1, 2}},
+		},
+		{
+			name:        "shortcut",
+			vertexCount: 5,
+			edges:       []edge{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {0, 4}},
+			sources:     []int{0},
+			want:        want{[]int{0, 1, 2, 2, 1}, []int{-1, 0, 1, 4, 0}},
+		},
+		{
+			name:        "disconnected",
+			vertexCount: 5,
+			edges:       []edge{{0, 1}, {2, 3}, {3, 4}},
+			sources:     []int{3},
+			want:        want{[]int{-1, -1, 1, 0, 1}, []int{-1, -1, 3, -1, 3}},
+		},
+		{
+			name:        "removed vertex",
+			vertexCount: 4,
+			edges:       []edge{{0, 1}, {1, 2}, {2, 3}, {0, 3}},
+			removed:     []int{3},
+			sources:     []int{0},
+			want:        want{[]int{0, 1, 2, -1}, []int{-1, 0, 1, -1}},
+		},
+		{
+			name:        "removed source",
+			vertexCount: 3,
+			edges:       []edge{{0, 1}, {1, 2}},
+			removed:     []int{0},
+			sources:     []int{0},
+			want:        want{[]int{-1, -1, -1}, []int{-1, -1, -1}},
+		},
+		{
+			name:        "multi source",
+			vertexCount: 7,
+			edges:       []edge{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}},
+			sources:     []int{0, 6, 6},
+			want:        want{[]int{0, 1, 2, 3, 2, 1, 0}, []int{-1, 0, 1, 2, 5, 6, -1}},
+		},
+		{
+			name:        "multi source disconnected",
+			vertexCount: 6,
+			edges:       []edge{{0, 1}, {1, 2}, {3, 4}},
+			sources:     []int{2, 4, 9, -1},
+			want:        want{[]int{2, 1, 0, 1, 0, -1}, []int{1, 2, -1, 4, -1, -1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewUndirectedGraph()
+			for i := 0; i < tt.vertexCount; i++ {
+				g.AddVertex("v for vertex")
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e.a, e.b, 0)
+			}
+			for _, v := range tt.removed {
+				g.RemoveVertex(v)
+			}
+			tree := g.MultiSourceBFSTree(tt.sources)
+			if fmt.Sprint(tree.Distance) != fmt.Sprint(tt.want.distance) {
+				t.Errorf("MultiSourceBFSTree() distance = %v, want %v", tree.Distance, tt.want.distance)
+			}
+			if fmt.Sprint(tree.Parent) != fmt.Sprint(tt.want.parent) {
+				t.Errorf("MultiSourceBFSTree() parent = %v, want %v", tree.Parent, tt.want.parent)
+			}
+			for v, d := range tt.want.distance {
+				path := tree.PathTo(v)
+				if d == -1 {
+					if path != nil {
+						t.Errorf("PathTo(%v) = %v, want nil", v, path)
+					}
+					continue
+				}
+				if len(path) != d+1 || path[len(path)-1] != v || tree.Distance[path[0]] != 0 {
+					t.Errorf("PathTo(%v) = %v, want a path of %v edges from a source", v, path, d)
+				}
+			}
+			if len(tt.sources) == 1 {
+				if got := g.BFSTree(tt.sources[0]); fmt.Sprint(got) != fmt.Sprint(tree) {
+					t.Errorf("BFSTree() = %v, want %v", got, tree)
+				}
+			}
+		})
+	}
+}
+
+func TestUndirectedGraph_ShortestPathUnweighted(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	for i := 0; i < 7; i++ {
+		g.AddVertex("v for vertex")
+	}
+	// a cycle 0 - 1 - 2 - 3 - 4 - 0 and a separate edge 5 - 6
+	for i := 0; i < 5; i++ {
+		g.AddEdge(i, (i+1)%5, 100)
+	}
+	g.AddEdge(5, 6, 0)
+	g.RemoveVertex(4)
+	tests := []struct {
+		name          string
+		start, target int
+		want          []int
+		err           error
+	}{
+		{"same vertex", 2, 2, []int{2}, nil},
+		{"around the removed vertex", 0, 3, []int{0, 1, 2, 3}, nil},
+		{"other component", 5, 6, []int{5, 6}, nil},
+		{"unreachable", 0, 6, nil, graph.ErrNoPath},
+		{"removed target", 0, 4, nil, graph.ErrVertexNotFound},
+		{"removed start", 4, 0, nil, graph.ErrVertexNotFound},
+		{"out of range", 0, 7, nil, graph.ErrVertexNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := g.ShortestPathUnweighted(tt.start, tt.target)
+			if err != tt.err || fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("ShortestPathUnweighted(%v, %v) = %v, %v, want %v, %v", tt.start, tt.target, got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
 // Bipartite example. Test if a graph is a bipartite.
 // In a graph where we color each vertex with a different color than adjacent vertices,
 // the graph is called bipartite if only two colors are enough to complete this operation.
 // As an example a binary tree is a bipartite graph. i.e. we can color odd levels with white and even levels with black.
 // A graph with 3 vertices A, B and C and 3 edges A - B, B - C and C - A requires 3 colors, therefore is not bipartite.
 func ExampleUndirectedGraph_BFS_bipartite() {
-	g := NewUndirectedGraph()
+	g := graph.NewUndirectedGraph()
 
 	for i := 0; i < 10; i++ {
 		g.AddVertex("v for vertex")
@@ -167,10 +311,10 @@ func ExampleUndirectedGraph_BFS_bipartite() {
 
 	bipartite := true
 
-	testEdgeColor := func(edge *Edge) (terminate bool) {
+	testEdgeColor := func(edge *graph.Edge) (terminate bool) {
 		terminate = false
-		if colors[edge.from] > 0 && colors[edge.to] > 0 {
-			if colors[edge.from] == colors[edge.to] {
+		if colors[edge.From] > 0 && colors[edge.To] > 0 {
+			if colors[edge.From] == colors[edge.To] {
 				terminate = true
 				bipartite = false
 				return
@@ -178,10 +322,10 @@ func ExampleUndirectedGraph_BFS_bipartite() {
 				return
 			}
 		}
-		if colors[edge.from] > 0 {
-			colors[edge.to] = 3 - colors[edge.from]
-		} else if colors[edge.to] > 0 {
-			colors[edge.from] = 3 - colors[edge.to]
+		if colors[edge.From] > 0 {
+			colors[edge.To] = 3 - colors[edge.From]
+		} else if colors[edge.To] > 0 {
+			colors[edge.From] = 3 - colors[edge.To]
 		}
 		return
 	}
@@ -194,7 +338,7 @@ func ExampleUndirectedGraph_BFS_bipartite() {
 	bipartite = true
 	colors = make([]int, 5)
 	colors[0] = 1
-	g2 := NewUndirectedGraph()
+	g2 := graph.NewUndirectedGraph()
 	for i := 0; i < 5; i++ {
 		g2.AddVertex("v for vertex")
 		if i > 0 {
@@ -217,7 +361,7 @@ func ExampleUndirectedGraph_BFS_bipartite() {
 
 // Connected Components example. Test if two vertices in a given graph are connected.
 func ExampleUndirectedGraph_BFS_connectedComponents() {
-	g := NewUndirectedGraph()
+	g := graph.NewUndirectedGraph()
 	for i := 0; i < 10; i++ {
 		g.AddVertex("v for vertex")
 		if i > 0 {
@@ -225,8 +369,8 @@ func ExampleUndirectedGraph_BFS_connectedComponents() {
 		}
 	}
 	connected := false
-	testConnectivity := func(testVertex int) func(id int, name string, edges []*Edge) {
-		return func(id int, name string, edges []*Edge) {
+	testConnectivity := func(testVertex int) func(id int, name string, edges []*graph.Edge) {
+		return func(id int, name string, edges []*graph.Edge) {
 			if id == testVertex {
 				connected = true
 			}
@@ -0,0 +1,187 @@
+package graph
+
+import "sort"
+
+// NamedEdge is an edge with the names of its ends resolved, for results that are reported to users.
+type NamedEdge struct {
+	Edge
+	FromName string `json:"fromName"`
+	ToName   string `json:"toName"`
+}
+
+// Components is the labelling of the vertices of a graph by connected component.
+type Components struct {
+	Label   []int       // Label[v] is the component of vertex v, -1 for removed vertices
+	Members [][]*Vertex // Members[c] lists the vertices of component c in increasing order of id
+}
+
+// ConnectedComponents labels the vertices of the graph by connected component with BFS.
+// Components are numbered from 0 in increasing order of their smallest vertex id.
+func (g *UndirectedGraph) ConnectedComponents() *Components {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	c := &Components{
+		Label:   make([]int, len(g.vertices)),
+		Members: make([][]*Vertex, 0),
+	}
+	for v := range c.Label {
+		c.Label[v] = -1
+	}
+	for _, start := range g.vertices {
+		if start == nil || c.Label[start.id] != -1 {
+			continue
+		}
+		label := len(c.Members)
+		members := make([]*Vertex, 0)
+		g.bfsSafe(start.id, func(id int, name string, edges []*Edge) {
+			c.Label[id] = label
+			members = append(members, &Vertex{ID: id, Name: name})
+		}, nil)
+		sort.Slice(members, func(i, j int) bool { return members[i].ID < members[j].ID })
+		c.Members = append(c.Members, members)
+	}
+	return c
+}
+
+// BiconnectedComponent is a maximal set of edges where every two edges lie on a common simple cycle, or a single bridge.
+// Removing any one vertex leaves the vertices of a biconnected component connected.
+type BiconnectedComponent struct {
+	Vertices []*Vertex    // in increasing order of id
+	Edges    []*NamedEdge // with From < To, in increasing order of From and then To
+}
+
+// Bridges returns the edges whose removal disconnects their ends, with From < To, in increasing order of From and then To.
+func (g *UndirectedGraph) Bridges() []*NamedEdge {
+	return g.tarjan().bridges
+}
+
+// ArticulationPoints returns the vertices whose removal disconnects some of the other vertices, in increasing order of id.
+func (g *UndirectedGraph) ArticulationPoints() []*Vertex {
+	return g.tarjan().points
+}
+
+// BiconnectedComponents returns the biconnected components of the graph, which partition its edges.
+// Isolated vertices are not in any component and articulation points are in more than one.
+// Components are in increasing order of their smallest edge.
+func (g *UndirectedGraph) BiconnectedComponents() []*BiconnectedComponent {
+	return g.tarjan().components
+}
+
+type tarjanResult struct {
+	bridges    []*NamedEdge
+	points     []*Vertex
+	components []*BiconnectedComponent
+}
+
+// tarjan finds bridges, articulation points and biconnected components in a single depth first search.
+// low[v] is the earliest discovery time that can be reached from the subtree of v with at most one back edge.
+// A tree edge p - c is a bridge if low[c] > disc[p], since nothing below c reaches p or above.
+// p is an articulation point if low[c] >= disc[p] for a child c, except the root, which is one if it has two or more children.
+// The edges are pushed on a stack as they are found, and the edges above p - c form a biconnected component when c finishes
+// with low[c] >= disc[p].
+func (g *UndirectedGraph) tarjan() *tarjanResult {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	n := len(g.vertices)
+	disc := make([]int, n)
+	low := make([]int, n)
+	parent := make([]int, n)
+	// weight[v] is the weight of the tree edge from the parent of v
+	weight := make([]int, n)
+	children := make([]int, n)
+	names := make([]string, n)
+	point := make([]bool, n)
+	for v := range parent {
+		// processEdge sees a tree edge before its child is discovered, so only roots keep -1
+		parent[v] = -1
+	}
+	stack := make([]*Edge, 0)
+	time := 0
+	r := &tarjanResult{
+		bridges:    make([]*NamedEdge, 0),
+		points:     make([]*Vertex, 0),
+		components: make([]*BiconnectedComponent, 0),
+	}
+	named := func(a, b, w int) *NamedEdge {
+		e := &NamedEdge{Edge: Edge{From: minInt(a, b), To: maxInt(a, b), Weight: w}}
+		e.FromName, e.ToName = names[e.From], names[e.To]
+		return e
+	}
+	preVertex := func(id int, name string, edges []*Edge) {
+		disc[id], low[id] = time, time
+		time++
+		names[id] = name
+	}
+	processEdge := func(e *Edge, edgeType EdgeType) bool {
+		stack = append(stack, e)
+		if edgeType == TreeEdge {
+			parent[e.To] = e.From
+			weight[e.To] = e.Weight
+			children[e.From]++
+		} else if disc[e.To] < low[e.From] {
+			low[e.From] = disc[e.To]
+		}
+		return false
+	}
+	postVertex := func(c int, name string, edges []*Edge) {
+		p := parent[c]
+		if p == -1 {
+			point[c] = children[c] >= 2
+			return
+		}
+		if low[c] < low[p] {
+			low[p] = low[c]
+		}
+		if low[c] > disc[p] {
+			r.bridges = append(r.bridges, named(p, c, weight[c]))
+		}
+		if low[c] < disc[p] {
+			return
+		}
+		if parent[p] != -1 {
+			point[p] = true
+		}
+		component := &BiconnectedComponent{Edges: make([]*NamedEdge, 0)}
+		for {
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component.Edges = append(component.Edges, named(e.From, e.To, e.Weight))
+			if e.From == p && e.To == c {
+				break
+			}
+		}
+		r.components = append(r.components, component)
+	}
+	g.dfsAllSafe(preVertex, postVertex, processEdge)
+
+	for v, ok := range point {
+		if ok {
+			r.points = append(r.points, &Vertex{ID: v, Name: names[v]})
+		}
+	}
+	sortNamedEdges(r.bridges)
+	for _, component := range r.components {
+		sortNamedEdges(component.Edges)
+		seen := make(map[int]bool)
+		for _, e := range component.Edges {
+			for _, v := range []int{e.From, e.To} {
+				if !seen[v] {
+					seen[v] = true
+					component.Vertices = append(component.Vertices, &Vertex{ID: v, Name: names[v]})
+				}
+			}
+		}
+		sort.Slice(component.Vertices, func(i, j int) bool { return component.Vertices[i].ID < component.Vertices[j].ID })
+	}
+	sort.Slice(r.components, func(i, j int) bool {
+		a, b := r.components[i].Edges[0], r.components[j].Edges[0]
+		return a.From < b.From || (a.From == b.From && a.To < b.To)
+	})
+	return r
+}
+
+func sortNamedEdges(edges []*NamedEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].From < edges[j].From || (edges[i].From == edges[j].From && edges[i].To < edges[j].To)
+	})
+}
@@ -0,0 +1,260 @@
+package graph_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"algorithms/graph"
+)
+
+func namedEdgeStrings(edges []*graph.NamedEdge) string {
+	r := make([]string, len(edges))
+	for i, e := range edges {
+		r[i] = fmt.Sprintf("%v-%v", e.FromName, e.ToName)
+	}
+	return strings.Join(r, " ")
+}
+
+func vertexNames(vertices []*graph.Vertex) string {
+	r := make([]string, len(vertices))
+	for i, v := range vertices {
+		r[i] = v.Name
+	}
+	return strings.Join(r, " ")
+}
+
+func TestUndirectedGraph_Connectivity(t *testing.T) {
+	type want struct {
+		labels     []int
+		bridges    string
+		points     string
+		components []string
+	}
+	tests := []struct {
+		name        string
+		vertexCount int
+		edges       []weightedEdge
+		removed     []int
+		want        want
+	}{
+		{
+			name:        "empty",
+			vertexCount: 0,
+			want:        want{[]int{}, "", "", []string{}},
+		},
+		{
+			name:        "cycle",
+			vertexCount: 5,
+			edges:       []weightedEdge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 4, 1}, {4, 0, 1}},
+			want:        want{[]int{0, 0, 0, 0, 0}, "", "", []string{"v0-v1 v0-v4 v1-v2 v2-v3 v3-v4"}},
+		},
+		{
+			name:        "path",
+			vertexCount: 3,
+			edges:       []weightedEdge{{0, 1, 1}, {1, 2, 1}},
+			want:        want{[]int{0, 0, 0}, "v0-v1 v1-v2", "v1", []string{"v0-v1", "v1-v2"}},
+		},
+		{
+			// two triangles joined by the bridge 2 - 3 with a pendant 6, an isolated vertex 7 and a separate edge 8 - 9
+			name:        "triangles",
+			vertexCount: 10,
+			edges: []weightedEdge{
+				{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {2, 3, 1}, {3, 4, 1}, {4, 5, 1}, {5, 3, 1}, {5, 6, 1}, {8, 9, 1},
+			},
+			want: want{
+				labels:     []int{0, 0, 0, 0, 0, 0, 0, 1, 2, 2},
+				bridges:    "v2-v3 v5-v6 v8-v9",
+				points:     "v2 v3 v5",
+				components: []string{"v0-v1 v0-v2 v1-v2", "v2-v3", "v3-v4 v3-v5 v4-v5", "v5-v6", "v8-v9"},
+			},
+		},
+		{
+			name:        "removed vertex",
+			vertexCount: 5,
+			edges:       []weightedEdge{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {2, 3, 1}, {3, 4, 1}, {4, 2, 1}},
+			removed:     []int{2},
+			want:        want{[]int{0, 0, -1, 1, 1}, "v0-v1 v3-v4", "", []string{"v0-v1", "v3-v4"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewUndirectedGraph()
+			for i := 0; i < tt.vertexCount; i++ {
+				g.AddVertex(fmt.Sprintf("v%v", i))
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e.a, e.b, e.w)
+			}
+			for _, v := range tt.removed {
+				g.RemoveVertex(v)
+			}
+			components := g.ConnectedComponents()
+			if fmt.Sprint(components.Label) != fmt.Sprint(tt.want.labels) {
+				t.Errorf("ConnectedComponents() = %v, want %v", components.Label, tt.want.labels)
+			}
+			for c, members := range components.Members {
+				for _, v := range members {
+					if components.Label[v.ID] != c || v.Name != fmt.Sprintf("v%v", v.ID) {
+						t.Errorf("ConnectedComponents() has %v in component %v", v, c)
+					}
+				}
+			}
+			if got := namedEdgeStrings(g.Bridges()); got != tt.want.bridges {
+				t.Errorf("Bridges() = %v, want %v", got, tt.want.bridges)
+			}
+			if got := vertexNames(g.ArticulationPoints()); got != tt.want.points {
+				t.Errorf("ArticulationPoints() = %v, want %v", got, tt.want.points)
+			}
+			biconnected := g.BiconnectedComponents()
+			got := make([]string, len(biconnected))
+			for i, component := range biconnected {
+				got[i] = namedEdgeStrings(component.Edges)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want.components) {
+				t.Errorf("BiconnectedComponents() = %v, want %v", got, tt.want.components)
+			}
+		})
+	}
+}
+
+func TestUndirectedGraph_ConnectivityJSON(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	g.AddVertex("router")
+	g.AddVertex("switch")
+	g.AddEdge(0, 1, 10)
+	b, err := json.Marshal(g.Bridges())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"from":0,"to":1,"weight":10,"fromName":"router","toName":"switch"}]`
+	if string(b) != want {
+		t.Errorf("json.Marshal(Bridges()) = %s, want %s", b, want)
+	}
+}
+
+// connectedPairs returns the number of pairs of vertices that are connected, skipping the vertex skip
+func connectedPairs(n int, edges [][2]int, skip int) int {
+	adj := make([][]int, n)
+	for _, e := range edges {
+		if e[0] != skip && e[1] != skip {
+			adj[e[0]] = append(adj[e[0]], e[1])
+			adj[e[1]] = append(adj[e[1]], e[0])
+		}
+	}
+	seen := make([]bool, n)
+	pairs := 0
+	for s := 0; s < n; s++ {
+		if seen[s] || s == skip {
+			continue
+		}
+		seen[s] = true
+		size := 0
+		for queue := []int{s}; len(queue) > 0; queue = queue[1:] {
+			size++
+			for _, v := range adj[queue[0]] {
+				if !seen[v] {
+					seen[v] = true
+					queue = append(queue, v)
+				}
+			}
+		}
+		pairs += size * (size - 1) / 2
+	}
+	return pairs
+}
+
+func TestUndirectedGraph_ConnectivityRandom(t *testing.T) {
+	for c := 0; c < 200; c++ {
+		n := 1 + rand.Intn(12)
+		g := graph.NewUndirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddVertex(fmt.Sprintf("v%v", i))
+		}
+		for i := rand.Intn(2 * n); i > 0; i-- {
+			g.AddEdge(rand.Intn(n), rand.Intn(n), 1)
+		}
+		edges := make([][2]int, 0)
+		for _, e := range g.Edges() {
+			edges = append(edges, [2]int{minInt(e.From, e.To), maxInt(e.From, e.To)})
+		}
+		sort.Slice(edges, func(i, j int) bool {
+			return edges[i][0] < edges[j][0] || (edges[i][0] == edges[j][0] && edges[i][1] < edges[j][1])
+		})
+		// a bridge is an edge whose removal disconnects some pair of vertices
+		all := connectedPairs(n, edges, -1)
+		wantBridges := make([]string, 0)
+		for i, e := range edges {
+			rest := append(append([][2]int{}, edges[:i]...), edges[i+1:]...)
+			if connectedPairs(n, rest, -1) < all {
+				wantBridges = append(wantBridges, fmt.Sprintf("v%v-v%v", e[0], e[1]))
+			}
+		}
+		if got := namedEdgeStrings(g.Bridges()); got != strings.Join(wantBridges, " ") {
+			t.Fatalf("Bridges() = %v, want %v", got, wantBridges)
+		}
+		// an articulation point is a vertex whose removal disconnects some pair of the other vertices
+		wantPoints := make([]string, 0)
+		labels := g.ConnectedComponents().Label
+		for v := 0; v < n; v++ {
+			size := 0
+			for _, l := range labels {
+				if l == labels[v] {
+					size++
+				}
+			}
+			// removing v takes away the size - 1 pairs it was in
+			if connectedPairs(n, edges, v) < all-(size-1) {
+				wantPoints = append(wantPoints, fmt.Sprintf("v%v", v))
+			}
+		}
+		if got := vertexNames(g.ArticulationPoints()); got != strings.Join(wantPoints, " ") {
+			t.Fatalf("ArticulationPoints() = %v, want %v", got, wantPoints)
+		}
+		// biconnected components partition the edges, and articulation points are the vertices in more than one of them
+		count := make(map[string]int)
+		in := make(map[string]int)
+		for _, component := range g.BiconnectedComponents() {
+			for _, e := range component.Edges {
+				count[fmt.Sprintf("v%v-v%v", e.From, e.To)]++
+			}
+			for _, v := range component.Vertices {
+				in[v.Name]++
+			}
+		}
+		if len(count) != len(edges) {
+			t.Fatalf("BiconnectedComponents() cover %v edges, want %v", len(count), len(edges))
+		}
+		for e, k := range count {
+			if k != 1 {
+				t.Fatalf("BiconnectedComponents() have %v %v times", e, k)
+			}
+		}
+		points := 0
+		for _, k := range in {
+			if k > 1 {
+				points++
+			}
+		}
+		if points != len(wantPoints) {
+			t.Fatalf("BiconnectedComponents() share %v vertices, want %v", points, len(wantPoints))
+		}
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
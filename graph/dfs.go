@@ -0,0 +1,179 @@
+package graph
+
+// EdgeType is the classification of an edge by a depth first search.
+// In an undirected graph every edge is either a tree edge or a back edge.
+type EdgeType int
+
+const (
+	// TreeEdge leads to a vertex that is discovered for the first time
+	TreeEdge EdgeType = iota
+	// BackEdge leads to an ancestor of the vertex on the depth first search tree, i.e. it closes a cycle
+	BackEdge
+	// ForwardEdge leads to a descendant of the vertex that is already finished, only in directed graphs
+	ForwardEdge
+	// CrossEdge leads to a vertex that is neither an ancestor nor a descendant, only in directed graphs
+	CrossEdge
+)
+
+// String returns the name of the edge type
+func (t EdgeType) String() string {
+	switch t {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	}
+	return "unknown"
+}
+
+// DFSTree is the depth first search forest that is found by DFS or DFSAll. Slices are indexed by vertex id.
+// Times come from a single clock that ticks once on each discovery and each finish,
+// so u is a descendant of v if and only if Discovery[v] < Discovery[u] < Finish[u] < Finish[v].
+type DFSTree struct {
+	Parent    []int // Parent[v] is the vertex that discovered v, -1 for roots and undiscovered vertices
+	Discovery []int // Discovery[v] is the time v is discovered, -1 if it is not discovered
+	Finish    []int // Finish[v] is the time all the edges of v are explored, -1 if it is not finished
+}
+
+// dfsFrame is an entry of the explicit stack of DFS, e is the next edge of v to explore
+type dfsFrame struct {
+	v int
+	e *edge
+}
+
+// DFS performs a depth first search in the graph g starting from a vertex whose id is given as input.
+// The search uses an explicit stack, so long paths do not cause deep recursion.
+// preVertex function is called with vertex id, vertex name and all the 'outgoing' edges of the vertex once a vertex is discovered
+// postVertex function is called with the same arguments once all the edges of the vertex are explored
+// processEdge function is called once for every edge with its type, edges of an undirected graph are directed the way they are traversed.
+// The search stops if processEdge returns true. Returns the search tree found so far.
+func (g *adjacencyList) DFS(start int,
+	preVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	postVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	processEdge func(edge *Edge, edgeType EdgeType) (terminate bool)) *DFSTree {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	t := g.newDFSTree()
+	if g.validVertexSafe(start) {
+		g.dfs(t, start, 0, preVertex, postVertex, processEdge)
+	}
+	return t
+}
+
+// DFSAll performs a depth first search from every vertex that is not discovered yet in increasing order of id,
+// so every vertex of the graph is visited. Callbacks are the same as DFS. Returns the search forest found so far.
+func (g *adjacencyList) DFSAll(
+	preVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	postVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	processEdge func(edge *Edge, edgeType EdgeType) (terminate bool)) *DFSTree {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	return g.dfsAllSafe(preVertex, postVertex, processEdge)
+}
+
+// dfsAllSafe is DFSAll, it's assumed that the read lock is held
+func (g *adjacencyList) dfsAllSafe(
+	preVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	postVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	processEdge func(edge *Edge, edgeType EdgeType) (terminate bool)) *DFSTree {
+	t := g.newDFSTree()
+	var time int
+	var terminated bool
+	for v := range g.vertices {
+		if g.vertices[v] == nil || t.Discovery[v] != -1 {
+			continue
+		}
+		if time, terminated = g.dfs(t, v, time, preVertex, postVertex, processEdge); terminated {
+			break
+		}
+	}
+	return t
+}
+
+// HasCycle returns true if the graph has a cycle, i.e. a depth first search finds a back edge
+func (g *adjacencyList) HasCycle() bool {
+	cycle := false
+	g.DFSAll(nil, nil, func(edge *Edge, edgeType EdgeType) bool {
+		cycle = edgeType == BackEdge
+		return cycle
+	})
+	return cycle
+}
+
+func (g *adjacencyList) newDFSTree() *DFSTree {
+	t := &DFSTree{
+		Parent:    make([]int, len(g.vertices)),
+		Discovery: make([]int, len(g.vertices)),
+		Finish:    make([]int, len(g.vertices)),
+	}
+	for v := range t.Parent {
+		t.Parent[v] = -1
+		t.Discovery[v] = -1
+		t.Finish[v] = -1
+	}
+	return t
+}
+
+// dfs explores everything that is reachable from start and not discovered yet, the clock starts at time.
+// Returns the time after the search and true if processEdge terminated the search.
+// it's assumed that the read lock is held and start is a valid vertex
+func (g *adjacencyList) dfs(t *DFSTree, start, time int,
+	preVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	postVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+	processEdge func(edge *Edge, edgeType EdgeType) (terminate bool)) (int, bool) {
+	discover := func(v int) {
+		t.Discovery[v] = time
+		time++
+		if preVertex != nil {
+			preVertex(g.vertices[v].id, g.vertices[v].name, g.vertexEdgesSafe(v))
+		}
+	}
+	discover(start)
+	stack := []dfsFrame{{v: start, e: g.vertices[start].edges}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		v, e := top.v, top.e
+		if e == nil {
+			stack = stack[:len(stack)-1]
+			t.Finish[v] = time
+			time++
+			if postVertex != nil {
+				postVertex(g.vertices[v].id, g.vertices[v].name, g.vertexEdgesSafe(v))
+			}
+			continue
+		}
+		top.e = e.next
+		y := e.to
+		switch {
+		case t.Discovery[y] == -1:
+			if processEdge != nil && processEdge(&Edge{From: v, To: y, Weight: e.weight}, TreeEdge) {
+				return time, true
+			}
+			t.Parent[y] = v
+			discover(y)
+			stack = append(stack, dfsFrame{v: y, e: g.vertices[y].edges})
+		case t.Finish[y] == -1:
+			// y is an ancestor of v. in an undirected graph the edge back to the parent is the tree edge seen from the other end
+			if !g.directed && y == t.Parent[v] {
+				continue
+			}
+			if processEdge != nil && processEdge(&Edge{From: v, To: y, Weight: e.weight}, BackEdge) {
+				return time, true
+			}
+		case g.directed:
+			// in an undirected graph an edge to a finished vertex is a back edge that was already seen from its lower end
+			edgeType := CrossEdge
+			if t.Discovery[y] > t.Discovery[v] {
+				edgeType = ForwardEdge
+			}
+			if processEdge != nil && processEdge(&Edge{From: v, To: y, Weight: e.weight}, edgeType) {
+				return time, true
+			}
+		}
+	}
+	return time, false
+}
@@ -0,0 +1,305 @@
+package graph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"algorithms/graph"
+)
+
+func TestUndirectedGraph_DFS(t *testing.T) {
+	var pre, post []int
+	var edges []string
+	type edge struct {
+		a, b int
+	}
+	type args struct {
+		vertexCount int
+		edges       []edge
+		start       int
+	}
+	type want struct {
+		pre, post []int
+		edges     []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "case 1",
+			args: args{
+				vertexCount: 5,
+				edges:       []edge{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+				start:       0,
+			},
+			want: want{
+				pre:   []int{0, 1, 2, 3, 4},
+				post:  []int{4, 3, 2, 1, 0},
+				edges: []string{"0-1 tree", "1-2 tree", "2-3 tree", "3-4 tree"},
+			},
+		},
+		{
+			name: "case 2",
+			args: args{
+				vertexCount: 5,
+				edges:       []edge{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+				start:       2,
+			},
+			want: want{
+				pre:   []int{2, 3, 4, 1, 0},
+				post:  []int{4, 3, 0, 1, 2},
+				edges: []string{"2-3 tree", "3-4 tree", "2-1 tree", "1-0 tree"},
+			},
+		},
+		{
+			name: "case 3",
+			args: args{
+				vertexCount: 6,
+				edges:       []edge{{0, 5}, {0, 4}, {0, 1}, {1, 4}, {1, 2}, {4, 3}, {2, 3}},
+				start:       0,
+			},
+			want: want{
+				pre:   []int{0, 1, 2, 3, 4, 5},
+				post:  []int{4, 3, 2, 1, 5, 0},
+				edges: []string{"0-1 tree", "1-2 tree", "2-3 tree", "3-4 tree", "4-1 back", "4-0 back", "0-5 tree"},
+			},
+		},
+		{
+			name: "disconnected",
+			args: args{
+				vertexCount: 4,
+				edges:       []edge{{0, 1}, {2, 3}},
+				start:       3,
+			},
+			want: want{
+				pre:   []int{3, 2},
+				post:  []int{2, 3},
+				edges: []string{"3-2 tree"},
+			},
+		},
+		{
+			name: "invalid start",
+			args: args{
+				vertexCount: 2,
+				edges:       []edge{{0, 1}},
+				start:       2,
+			},
+			want: want{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewUndirectedGraph()
+			for i := 0; i < tt.args.vertexCount; i++ {
+				g.AddVertex("v for vertex")
+			}
+			for _, e := range tt.args.edges {
+				g.AddEdge(e.a, e.b, 0)
+			}
+			pre, post, edges = make([]int, 0), make([]int, 0), make([]string, 0)
+			tree := g.DFS(tt.args.start,
+				func(id int, name string, edges []*graph.Edge) {
+					pre = append(pre, id)
+				},
+				func(id int, name string, edges []*graph.Edge) {
+					post = append(post, id)
+				},
+				func(edge *graph.Edge, edgeType graph.EdgeType) bool {
+					edges = append(edges, fmt.Sprintf("%v-%v %v", edge.From, edge.To, edgeType))
+					return false
+				})
+			if fmt.Sprint(pre) != fmt.Sprint(tt.want.pre) || fmt.Sprint(post) != fmt.Sprint(tt.want.post) {
+				t.Fatalf("different expected and actual order want: %v %v actual: %v %v", tt.want.pre, tt.want.post, pre, post)
+			}
+			if fmt.Sprint(edges) != fmt.Sprint(tt.want.edges) {
+				t.Fatalf("different expected and actual edges want: %v actual: %v", tt.want.edges, edges)
+			}
+			// times must agree with the callback order
+			for i, v := range pre {
+				if tree.Discovery[v] < 0 || (i > 0 && tree.Discovery[v] < tree.Discovery[pre[i-1]]) {
+					t.Fatalf("discovery times %v do not follow pre order %v", tree.Discovery, pre)
+				}
+			}
+			for i, v := range post {
+				if tree.Finish[v] <= tree.Discovery[v] || (i > 0 && tree.Finish[v] < tree.Finish[post[i-1]]) {
+					t.Fatalf("finish times %v do not follow post order %v", tree.Finish, post)
+				}
+			}
+		})
+	}
+}
+
+func TestUndirectedGraph_DFSTimes(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	for i := 0; i < 6; i++ {
+		g.AddVertex("v for vertex")
+	}
+	for _, e := range [][2]int{{0, 5}, {0, 4}, {0, 1}, {1, 4}, {1, 2}, {4, 3}, {2, 3}} {
+		g.AddEdge(e[0], e[1], 0)
+	}
+	tree := g.DFS(0, nil, nil, nil)
+	want := &graph.DFSTree{
+		Parent:    []int{-1, 0, 1, 2, 3, 0},
+		Discovery: []int{0, 1, 2, 3, 4, 9},
+		Finish:    []int{11, 8, 7, 6, 5, 10},
+	}
+	if fmt.Sprint(tree) != fmt.Sprint(want) {
+		t.Errorf("DFS() = %v, want %v", tree, want)
+	}
+}
+
+func TestUndirectedGraph_DFSAll(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	for i := 0; i < 7; i++ {
+		g.AddVertex("v for vertex")
+	}
+	// components 0 - 1, 2 - 3 - 4 - 2 and 6, vertex 5 is removed
+	for _, e := range [][2]int{{0, 1}, {2, 3}, {3, 4}, {4, 2}, {5, 6}} {
+		g.AddEdge(e[0], e[1], 0)
+	}
+	g.RemoveVertex(5)
+	roots := make([]int, 0)
+	tree := g.DFSAll(nil, nil, nil)
+	for v, p := range tree.Parent {
+		if p == -1 && tree.Discovery[v] != -1 {
+			roots = append(roots, v)
+		}
+	}
+	if fmt.Sprint(roots) != "[0 2 6]" {
+		t.Errorf("DFSAll() roots = %v, want [0 2 6]", roots)
+	}
+	if tree.Discovery[5] != -1 || tree.Finish[5] != -1 {
+		t.Errorf("DFSAll() discovered removed vertex 5")
+	}
+	if tree.Finish[6] != 2*6-1 {
+		t.Errorf("DFSAll() last finish time = %v, want %v", tree.Finish[6], 2*6-1)
+	}
+
+	// terminating stops the whole forest
+	count := 0
+	tree = g.DFSAll(nil, nil, func(edge *graph.Edge, edgeType graph.EdgeType) bool {
+		count++
+		return edge.From == 2
+	})
+	if count != 2 || tree.Discovery[6] != -1 {
+		t.Errorf("DFSAll() did not stop, %v edges processed", count)
+	}
+}
+
+func TestUndirectedGraph_DFSLongPath(t *testing.T) {
+	// a recursive search would need a call per vertex
+	n := 100000
+	g := graph.NewUndirectedGraph()
+	for i := 0; i < n; i++ {
+		g.AddVertex("v for vertex")
+		if i > 0 {
+			g.AddEdge(i-1, i, 0)
+		}
+	}
+	tree := g.DFS(0, nil, nil, nil)
+	if tree.Discovery[n-1] != n-1 || tree.Finish[0] != 2*n-1 {
+		t.Errorf("DFS() discovery of last = %v, finish of first = %v", tree.Discovery[n-1], tree.Finish[0])
+	}
+}
+
+func TestUndirectedGraph_HasCycle(t *testing.T) {
+	type edge struct {
+		a, b int
+	}
+	tests := []struct {
+		name        string
+		vertexCount int
+		edges       []edge
+		removed     []int
+		want        bool
+	}{
+		{
+			name:        "empty",
+			vertexCount: 0,
+			want:        false,
+		},
+		{
+			name:        "tree",
+			vertexCount: 5,
+			edges:       []edge{{0, 1}, {0, 2}, {1, 3}, {1, 4}},
+			want:        false,
+		},
+		{
+			name:        "triangle",
+			vertexCount: 3,
+			edges:       []edge{{0, 1}, {1, 2}, {2, 0}},
+			want:        true,
+		},
+		{
+			name:        "cycle in second component",
+			vertexCount: 6,
+			edges:       []edge{{0, 1}, {2, 3}, {3, 4}, {4, 5}, {5, 2}},
+			want:        true,
+		},
+		{
+			name:        "cycle broken by removed vertex",
+			vertexCount: 4,
+			edges:       []edge{{0, 1}, {1, 2}, {2, 3}, {3, 0}},
+			removed:     []int{2},
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewUndirectedGraph()
+			for i := 0; i < tt.vertexCount; i++ {
+				g.AddVertex("v for vertex")
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e.a, e.b, 0)
+			}
+			for _, v := range tt.removed {
+				g.RemoveVertex(v)
+			}
+			if got := g.HasCycle(); got != tt.want {
+				t.Errorf("HasCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Cycle example. A back edge leads from a vertex to one of its ancestors on the search tree,
+// following the tree edges from the ancestor down to the vertex and taking the back edge closes a cycle.
+func ExampleUndirectedGraph_DFS_cycle() {
+	g := graph.NewUndirectedGraph()
+	for i := 0; i < 6; i++ {
+		g.AddVertex("v for vertex")
+	}
+	g.AddEdge(0, 1, 0)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 3, 0)
+	g.AddEdge(3, 4, 0)
+	g.AddEdge(4, 1, 0)
+	g.AddEdge(4, 5, 0)
+
+	// parents are recorded from tree edges, so the cycle can be found by walking up from the back edge
+	parent := make([]int, 6)
+	var cycle []int
+	findCycle := func(edge *graph.Edge, edgeType graph.EdgeType) (terminate bool) {
+		if edgeType == graph.TreeEdge {
+			parent[edge.To] = edge.From
+			return false
+		}
+		for v := edge.From; v != edge.To; v = parent[v] {
+			cycle = append(cycle, v)
+		}
+		cycle = append(cycle, edge.To)
+		return true
+	}
+	g.DFS(0, nil, nil, findCycle)
+	fmt.Println(cycle)
+
+	g.RemoveEdge(1, 4)
+	fmt.Println(g.HasCycle())
+
+	// Output:
+	// [2 3 4 1]
+	// false
+}
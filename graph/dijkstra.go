@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"math"
+
+	"algorithms/datastructures/minpriorityqueue"
+)
+
+// Unreachable is the distance of a vertex that can not be reached from the source
+const Unreachable = math.MaxInt64
+
+// ShortestPaths is the shortest path tree from a single source on the edge weights. Slices are indexed by vertex id.
+type ShortestPaths struct {
+	Source   int
+	Distance []int // Distance[v] is the total weight of a shortest path from the source to v, Unreachable if there is no path
+	Parent   []int // Parent[v] is the vertex before v on that path, -1 for the source and unreachable vertices
+}
+
+// newShortestPaths returns shortest paths where every vertex is unreachable except the source
+func newShortestPaths(source, n int) *ShortestPaths {
+	p := &ShortestPaths{
+		Source:   source,
+		Distance: make([]int, n),
+		Parent:   make([]int, n),
+	}
+	for v := range p.Distance {
+		p.Distance[v] = Unreachable
+		p.Parent[v] = -1
+	}
+	p.Distance[source] = 0
+	return p
+}
+
+// PathTo returns the vertices on a shortest path from the source to target, both ends included.
+// Returns nil if target is unreachable.
+func (p *ShortestPaths) PathTo(target int) []int {
+	if target < 0 || target >= len(p.Distance) || p.Distance[target] == Unreachable {
+		return nil
+	}
+	path := make([]int, 0)
+	for v := target; v != -1; v = p.Parent[v] {
+		path = append(path, v)
+	}
+	for k, l := 0, len(path)-1; k < l; k, l = k+1, l-1 {
+		path[k], path[l] = path[l], path[k]
+	}
+	return path
+}
+
+// Dijkstra finds the shortest paths from the vertex with id source to all other vertices on the edge weights.
+// Vertices are taken in increasing order of distance from a min heap, whose keys are decreased as shorter paths are found.
+// Returns ErrVertexNotFound if source is not a vertex of the graph and ErrNegativeWeight if any edge has a negative weight.
+func (g *adjacencyList) Dijkstra(source int) (*ShortestPaths, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(source) {
+		return nil, ErrVertexNotFound
+	}
+	for _, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		for e := v.edges; e != nil; e = e.next {
+			if e.weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+		}
+	}
+	return g.dijkstraSafe(source, func(v int, e *edge) int { return e.weight }), nil
+}
+
+// dijkstraSafe runs Dijkstra's algorithm on the weights given by weight for edge e of vertex v, which must not be negative.
+// it's assumed that the read lock is held and source is a valid vertex
+func (g *adjacencyList) dijkstraSafe(source int, weight func(v int, e *edge) int) *ShortestPaths {
+	p := newShortestPaths(source, len(g.vertices))
+	q := minpriorityqueue.NewMinPriorityQueue(len(g.vertices))
+	q.Insert(source, 0)
+	for q.Len() > 0 {
+		v, d := q.ExtractMinimum()
+		for e := g.vertices[v].edges; e != nil; e = e.next {
+			w := weight(v, e)
+			if d+w >= p.Distance[e.to] {
+				continue
+			}
+			if p.Distance[e.to] == Unreachable {
+				q.Insert(e.to, d+w)
+			} else {
+				q.DecreaseKey(e.to, d+w)
+			}
+			p.Distance[e.to] = d + w
+			p.Parent[e.to] = v
+		}
+	}
+	return p
+}
@@ -0,0 +1,205 @@
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"algorithms/graph"
+)
+
+type weightedEdge struct {
+	a, b, w int
+}
+
+func newWeightedGraph(g searchableGraph, vertexCount int, edges []weightedEdge) searchableGraph {
+	for i := 0; i < vertexCount; i++ {
+		g.AddVertex(fmt.Sprintf("vertex_%v", i))
+	}
+	for _, e := range edges {
+		g.AddEdge(e.a, e.b, e.w)
+	}
+	return g
+}
+
+// bellmanFord relaxes every edge n-1 times, it is the reference for shortest path algorithms.
+// Returns true if an edge can be relaxed once more, i.e. a negative cycle can be reached.
+func bellmanFord(g searchableGraph, source int) ([]int, bool) {
+	n := 0
+	for _, v := range g.Vertices() {
+		if v.ID >= n {
+			n = v.ID + 1
+		}
+	}
+	dist := make([]int, n)
+	for v := range dist {
+		dist[v] = graph.Unreachable
+	}
+	dist[source] = 0
+	for i := 1; i < n; i++ {
+		for _, v := range g.Vertices() {
+			for _, e := range g.VertexEdges(v.ID) {
+				if dist[e.From] != graph.Unreachable && dist[e.From]+e.Weight < dist[e.To] {
+					dist[e.To] = dist[e.From] + e.Weight
+				}
+			}
+		}
+	}
+	for _, v := range g.Vertices() {
+		for _, e := range g.VertexEdges(v.ID) {
+			if dist[e.From] != graph.Unreachable && dist[e.From]+e.Weight < dist[e.To] {
+				return dist, true
+			}
+		}
+	}
+	return dist, false
+}
+
+// sameDistances compares distances to the reference, which has no entries for removed vertices after the last vertex
+func sameDistances(got, want []int) bool {
+	if len(got) < len(want) {
+		return false
+	}
+	for v := range got {
+		if (v < len(want) && got[v] != want[v]) || (v >= len(want) && got[v] != graph.Unreachable) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkShortestPaths checks that the paths agree with the distances and the edge weights of the graph
+func checkShortestPaths(t *testing.T, g searchableGraph, p *graph.ShortestPaths) {
+	for v, d := range p.Distance {
+		path := p.PathTo(v)
+		if d == graph.Unreachable {
+			if path != nil {
+				t.Fatalf("PathTo(%v) = %v, want nil", v, path)
+			}
+			continue
+		}
+		if len(path) == 0 || path[0] != p.Source || path[len(path)-1] != v {
+			t.Fatalf("PathTo(%v) = %v, want a path from %v", v, path, p.Source)
+		}
+		total := 0
+		for i := 1; i < len(path); i++ {
+			found := false
+			for _, e := range g.VertexEdges(path[i-1]) {
+				if e.To == path[i] {
+					total += e.Weight
+					found = true
+				}
+			}
+			if !found {
+				t.Fatalf("PathTo(%v) = %v uses a missing edge %v - %v", v, path, path[i-1], path[i])
+			}
+		}
+		if total != d {
+			t.Fatalf("PathTo(%v) = %v weighs %v, want %v", v, path, total, d)
+		}
+	}
+}
+
+func TestGraph_Dijkstra(t *testing.T) {
+	// s = 0, t = 1, x = 2, y = 3, z = 4 from the example in CLRS
+	clrs := []weightedEdge{{0, 1, 10}, {0, 3, 5}, {1, 2, 1}, {1, 3, 2}, {2, 4, 4}, {3, 1, 3}, {3, 2, 9}, {3, 4, 2}, {4, 0, 7}, {4, 2, 6}}
+	tests := []struct {
+		name   string
+		g      searchableGraph
+		source int
+		want   []int
+		err    error
+	}{
+		{
+			name:   "directed",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 5, clrs),
+			source: 0,
+			want:   []int{0, 8, 9, 5, 7},
+		},
+		{
+			name:   "undirected",
+			g:      newWeightedGraph(graph.NewUndirectedGraph(), 5, clrs),
+			source: 4,
+			want:   []int{7, 5, 6, 2, 0},
+		},
+		{
+			name:   "unreachable",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 4, []weightedEdge{{0, 1, 3}, {2, 1, 1}, {3, 2, 0}}),
+			source: 0,
+			want:   []int{0, 3, graph.Unreachable, graph.Unreachable},
+		},
+		{
+			name:   "zero weights",
+			g:      newWeightedGraph(graph.NewUndirectedGraph(), 4, []weightedEdge{{0, 1, 0}, {1, 2, 0}, {0, 2, 1}, {2, 3, 5}}),
+			source: 3,
+			want:   []int{5, 5, 5, 0},
+		},
+		{
+			name:   "negative weight",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 3, []weightedEdge{{0, 1, 3}, {2, 1, -1}}),
+			source: 0,
+			err:    graph.ErrNegativeWeight,
+		},
+		{
+			name:   "invalid source",
+			g:      newWeightedGraph(graph.NewDirectedGraph(), 3, []weightedEdge{{0, 1, 3}}),
+			source: 3,
+			err:    graph.ErrVertexNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.Dijkstra(tt.source)
+			if err != tt.err {
+				t.Fatalf("Dijkstra() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if fmt.Sprint(got.Distance) != fmt.Sprint(tt.want) {
+				t.Errorf("Dijkstra() distance = %v, want %v", got.Distance, tt.want)
+			}
+			checkShortestPaths(t, tt.g, got)
+		})
+	}
+}
+
+func TestGraph_DijkstraRemovedVertex(t *testing.T) {
+	g := newWeightedGraph(graph.NewUndirectedGraph(), 4, []weightedEdge{{0, 1, 1}, {1, 3, 1}, {0, 2, 5}, {2, 3, 5}})
+	g.RemoveVertex(1)
+	got, err := g.Dijkstra(0)
+	if err != nil || fmt.Sprint(got.Distance) != fmt.Sprint([]int{0, graph.Unreachable, 5, 10}) {
+		t.Fatalf("Dijkstra() = %v, %v", got, err)
+	}
+	if path := got.PathTo(3); fmt.Sprint(path) != "[0 2 3]" {
+		t.Errorf("PathTo(3) = %v, want [0 2 3]", path)
+	}
+	if _, err := g.Dijkstra(1); err != graph.ErrVertexNotFound {
+		t.Errorf("Dijkstra() from a removed vertex error = %v, want %v", err, graph.ErrVertexNotFound)
+	}
+}
+
+func TestGraph_DijkstraRandom(t *testing.T) {
+	for c := 0; c < 100; c++ {
+		n := 1 + rand.Intn(30)
+		var g searchableGraph = graph.NewUndirectedGraph()
+		if c%2 == 0 {
+			g = graph.NewDirectedGraph()
+		}
+		for i := 0; i < n; i++ {
+			g.AddVertex(fmt.Sprintf("vertex_%v", i))
+		}
+		for i := rand.Intn(4 * n); i > 0; i-- {
+			g.AddEdge(rand.Intn(n), rand.Intn(n), rand.Intn(20))
+		}
+		source := rand.Intn(n)
+		got, err := g.Dijkstra(source)
+		if err != nil {
+			t.Fatalf("Dijkstra() error = %v", err)
+		}
+		if want, _ := bellmanFord(g, source); !sameDistances(got.Distance, want) {
+			t.Fatalf("Dijkstra() distance = %v, want %v", got.Distance, want)
+		}
+		checkShortestPaths(t, g, got)
+	}
+}
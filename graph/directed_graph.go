@@ -0,0 +1,153 @@
+package graph
+
+// DirectedGraph is adjacency list graph structure where every edge goes from one vertex to another.
+// Every vertex keeps its incoming edges as well as its outgoing edges, so both can be listed without scanning the graph.
+type DirectedGraph struct {
+	adjacencyList
+}
+
+// NewDirectedGraph returns a new directed graph
+func NewDirectedGraph() *DirectedGraph {
+	return &DirectedGraph{
+		adjacencyList: newAdjacencyList(true),
+	}
+}
+
+// RemoveVertex removes a vertex and all its incoming and outgoing edges
+func (g *DirectedGraph) RemoveVertex(a int) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if !g.validVertexSafe(a) {
+		return
+	}
+	for e := g.vertices[a].edges; e != nil; e = e.next {
+		deleteEdge(&g.vertices[e.to].in, a)
+	}
+	for e := g.vertices[a].in; e != nil; e = e.next {
+		deleteEdge(&g.vertices[e.to].edges, a)
+	}
+	g.vertices[a] = nil
+}
+
+// AddEdge adds an edge with weight w from vertex with id a to vertex with id b.
+// updates the weight if the edge already exists.
+func (g *DirectedGraph) AddEdge(a, b, w int) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if !g.validVertexSafe(a) || !g.validVertexSafe(b) {
+		return
+	}
+	if a == b {
+		return
+	}
+	setEdge(&g.vertices[a].edges, b, w)
+	setEdge(&g.vertices[b].in, a, w)
+}
+
+// RemoveEdge removes the edge from vertex with id a to vertex with id b, the edge from b to a is kept.
+func (g *DirectedGraph) RemoveEdge(a, b int) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if !g.validVertexSafe(a) || !g.validVertexSafe(b) {
+		return
+	}
+	if deleteEdge(&g.vertices[a].edges, b) {
+		deleteEdge(&g.vertices[b].in, a)
+	}
+}
+
+// Edges lists all the edges in the graph. Returns nil if there are no edges
+func (g *DirectedGraph) Edges() []*Edge {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	r := make([]*Edge, 0)
+	for _, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		r = append(r, g.vertexEdgesSafe(v.id)...)
+	}
+	if len(r) == 0 {
+		r = nil
+	}
+	return r
+}
+
+// OutEdges lists the edges that leave a given vertex, it is the same as VertexEdges
+func (g *DirectedGraph) OutEdges(a int) []*Edge {
+	return g.VertexEdges(a)
+}
+
+// InEdges lists the edges that enter a given vertex. From is the vertex at the other end and To is a.
+func (g *DirectedGraph) InEdges(a int) []*Edge {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(a) {
+		return nil
+	}
+	r := make([]*Edge, 0)
+	for e := g.vertices[a].in; e != nil; e = e.next {
+		r = append(r, &Edge{From: e.to, To: a, Weight: e.weight})
+	}
+	if len(r) == 0 {
+		r = nil
+	}
+	return r
+}
+
+// OutDegree returns the number of edges that leave a given vertex, -1 if it is not a vertex of the graph
+func (g *DirectedGraph) OutDegree(a int) int {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(a) {
+		return -1
+	}
+	return listLength(g.vertices[a].edges)
+}
+
+// InDegree returns the number of edges that enter a given vertex, -1 if it is not a vertex of the graph
+func (g *DirectedGraph) InDegree(a int) int {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	if !g.validVertexSafe(a) {
+		return -1
+	}
+	return listLength(g.vertices[a].in)
+}
+
+// Reverse returns the transpose of the graph, i.e. a new graph with the same vertices and ids where every edge is reversed.
+func (g *DirectedGraph) Reverse() *DirectedGraph {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	r := NewDirectedGraph()
+	r.vertices = make([]*vertex, len(g.vertices))
+	for i, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		r.vertices[i] = &vertex{
+			id:    v.id,
+			name:  v.name,
+			edges: copyEdges(v.in),
+			in:    copyEdges(v.edges),
+		}
+	}
+	return r
+}
+
+func listLength(e *edge) int {
+	n := 0
+	for ; e != nil; e = e.next {
+		n++
+	}
+	return n
+}
+
+// copyEdges returns a copy of the list in the same order
+func copyEdges(e *edge) *edge {
+	var head *edge
+	for p := &head; e != nil; e, p = e.next, &(*p).next {
+		*p = &edge{to: e.to, weight: e.weight}
+	}
+	return head
+}
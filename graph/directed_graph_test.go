@@ -0,0 +1,337 @@
+package graph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"algorithms/graph"
+)
+
+// searchableGraph is a graph of either type together with the algorithms that both types have,
+// so that the same tests run on undirected and directed graphs
+type searchableGraph interface {
+	graph.Graph
+	BFSTree(start int) *graph.BFSTree
+	MultiSourceBFSTree(sources []int) *graph.BFSTree
+	ShortestPathUnweighted(start, target int) ([]int, error)
+	DFSAll(
+		preVertex func(vertexId int, vertexName string, vertexEdges []*graph.Edge),
+		postVertex func(vertexId int, vertexName string, vertexEdges []*graph.Edge),
+		processEdge func(edge *graph.Edge, edgeType graph.EdgeType) (terminate bool)) *graph.DFSTree
+	HasCycle() bool
+	Dijkstra(source int) (*graph.ShortestPaths, error)
+	BellmanFord(source int) (*graph.ShortestPaths, []int, error)
+	SPFA(source int) (*graph.ShortestPaths, []int, error)
+	FloydWarshall() (*graph.AllPairsShortestPaths, error)
+	Johnson() (*graph.AllPairsShortestPaths, error)
+	AStar(start, target int, h graph.Heuristic) (*graph.AStarResult, error)
+}
+
+var (
+	_ searchableGraph = graph.NewUndirectedGraph()
+	_ searchableGraph = graph.NewDirectedGraph()
+)
+
+func edgeStrings(edges []*graph.Edge) []string {
+	r := make([]string, len(edges))
+	for i, e := range edges {
+		r[i] = fmt.Sprintf("%v->%v:%v", e.From, e.To, e.Weight)
+	}
+	return r
+}
+
+func TestDirectedGraph_Edges(t *testing.T) {
+	type edge struct {
+		v1, v2, w int
+	}
+	type want struct {
+		out, in       []string
+		outDeg, inDeg int
+	}
+	tests := []struct {
+		name       string
+		vertices   int
+		edges      []edge
+		removed    []edge
+		testVertex int
+		want       want
+	}{
+		{
+			name:       "one direction",
+			vertices:   3,
+			edges:      []edge{{0, 1, 1}, {1, 2, 2}},
+			testVertex: 1,
+			want:       want{[]string{"1->2:2"}, []string{"0->1:1"}, 1, 1},
+		},
+		{
+			name:       "both directions",
+			vertices:   2,
+			edges:      []edge{{0, 1, 1}, {1, 0, 2}},
+			testVertex: 0,
+			want:       want{[]string{"0->1:1"}, []string{"1->0:2"}, 1, 1},
+		},
+		{
+			name:       "update weight",
+			vertices:   3,
+			edges:      []edge{{0, 1, 1}, {2, 1, 2}, {0, 1, 5}},
+			testVertex: 1,
+			want:       want{[]string{}, []string{"2->1:2", "0->1:5"}, 0, 2},
+		},
+		{
+			name:       "invalid edges are no-op",
+			vertices:   3,
+			edges:      []edge{{0, 1, 1}, {1, 1, 1}, {1, 5, 1}, {-1, 1, 1}},
+			testVertex: 1,
+			want:       want{[]string{}, []string{"0->1:1"}, 0, 1},
+		},
+		{
+			name:       "remove one direction",
+			vertices:   2,
+			edges:      []edge{{0, 1, 1}, {1, 0, 2}},
+			removed:    []edge{{0, 1, 0}},
+			testVertex: 0,
+			want:       want{[]string{}, []string{"1->0:2"}, 0, 1},
+		},
+		{
+			name:       "remove missing edge",
+			vertices:   3,
+			edges:      []edge{{0, 1, 1}},
+			removed:    []edge{{1, 0, 0}, {2, 1, 0}},
+			testVertex: 1,
+			want:       want{[]string{}, []string{"0->1:1"}, 0, 1},
+		},
+		{
+			name:       "invalid vertex",
+			vertices:   3,
+			testVertex: 3,
+			want:       want{[]string{}, []string{}, -1, -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewDirectedGraph()
+			for i := 0; i < tt.vertices; i++ {
+				g.AddVertex(fmt.Sprintf("vertex_%v", i))
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e.v1, e.v2, e.w)
+			}
+			for _, e := range tt.removed {
+				g.RemoveEdge(e.v1, e.v2)
+			}
+			if got := edgeStrings(g.OutEdges(tt.testVertex)); fmt.Sprint(got) != fmt.Sprint(tt.want.out) {
+				t.Errorf("OutEdges() = %v, want %v", got, tt.want.out)
+			}
+			if got := edgeStrings(g.VertexEdges(tt.testVertex)); fmt.Sprint(got) != fmt.Sprint(tt.want.out) {
+				t.Errorf("VertexEdges() = %v, want %v", got, tt.want.out)
+			}
+			if got := edgeStrings(g.InEdges(tt.testVertex)); fmt.Sprint(got) != fmt.Sprint(tt.want.in) {
+				t.Errorf("InEdges() = %v, want %v", got, tt.want.in)
+			}
+			if got := g.OutDegree(tt.testVertex); got != tt.want.outDeg {
+				t.Errorf("OutDegree() = %v, want %v", got, tt.want.outDeg)
+			}
+			if got := g.InDegree(tt.testVertex); got != tt.want.inDeg {
+				t.Errorf("InDegree() = %v, want %v", got, tt.want.inDeg)
+			}
+		})
+	}
+}
+
+func TestDirectedGraph_RemoveVertex(t *testing.T) {
+	g := graph.NewDirectedGraph()
+	for i := 0; i < 4; i++ {
+		g.AddVertex(fmt.Sprintf("vertex_%v", i))
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 2)
+	g.AddEdge(2, 1, 3)
+	g.AddEdge(3, 1, 4)
+	g.AddEdge(0, 3, 5)
+	g.RemoveVertex(1)
+	g.RemoveVertex(1)
+	if got := edgeStrings(g.Edges()); fmt.Sprint(got) != "[0->3:5]" {
+		t.Errorf("Edges() = %v, want [0->3:5]", got)
+	}
+	for v, want := range []int{1, -1, 0, 1} {
+		if got := g.InDegree(v) + g.OutDegree(v); got != want && !(want == -1 && got == -2) {
+			t.Errorf("degree of %v = %v, want %v", v, got, want)
+		}
+	}
+	if got := len(g.Vertices()); got != 3 {
+		t.Errorf("Vertices() has %v vertices, want 3", got)
+	}
+	if id := g.AddVertex("vertex_4"); id != 4 {
+		t.Errorf("AddVertex() = %v, want 4", id)
+	}
+}
+
+func TestDirectedGraph_Reverse(t *testing.T) {
+	g := graph.NewDirectedGraph()
+	for i := 0; i < 4; i++ {
+		g.AddVertex(fmt.Sprintf("vertex_%v", i))
+	}
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(0, 2, 2)
+	g.AddEdge(2, 3, 3)
+	g.AddEdge(3, 0, 4)
+	g.RemoveVertex(1)
+	r := g.Reverse()
+	if got := edgeStrings(r.Edges()); fmt.Sprint(got) != "[0->3:4 2->0:2 3->2:3]" {
+		t.Errorf("Reverse().Edges() = %v", got)
+	}
+	for i, v := range r.Vertices() {
+		if *v != *g.Vertices()[i] {
+			t.Errorf("Reverse().Vertices() has %v, want %v", *v, *g.Vertices()[i])
+		}
+	}
+	for v := 0; v < 4; v++ {
+		if r.InDegree(v) != g.OutDegree(v) || r.OutDegree(v) != g.InDegree(v) {
+			t.Errorf("degrees of %v are not swapped", v)
+		}
+	}
+	// the reverse is a separate graph
+	r.AddEdge(2, 3, 10)
+	if g.InDegree(2) != 1 || g.OutDegree(2) != 1 {
+		t.Errorf("changing the reverse changed the graph")
+	}
+	if got := edgeStrings(r.Reverse().Edges()); fmt.Sprint(got) != "[0->2:2 2->3:3 3->2:10 3->0:4]" {
+		t.Errorf("Reverse().Reverse().Edges() = %v", got)
+	}
+}
+
+func TestGraph_BFS(t *testing.T) {
+	// 0 -> 1 -> 2 -> 0 and 2 -> 3
+	build := func(g searchableGraph) searchableGraph {
+		for i := 0; i < 4; i++ {
+			g.AddVertex("v for vertex")
+		}
+		g.AddEdge(0, 1, 0)
+		g.AddEdge(1, 2, 0)
+		g.AddEdge(2, 0, 0)
+		g.AddEdge(2, 3, 0)
+		return g
+	}
+	tests := []struct {
+		name     string
+		g        searchableGraph
+		start    int
+		vertices []int
+		edges    int
+		distance []int
+	}{
+		{
+			name:     "undirected",
+			g:        build(graph.NewUndirectedGraph()),
+			start:    3,
+			vertices: []int{3, 2, 0, 1},
+			edges:    4,
+			distance: []int{2, 2, 1, 0},
+		},
+		{
+			name:     "directed",
+			g:        build(graph.NewDirectedGraph()),
+			start:    1,
+			vertices: []int{1, 2, 3, 0},
+			edges:    4,
+			distance: []int{2, 0, 1, 2},
+		},
+		{
+			name:     "directed dead end",
+			g:        build(graph.NewDirectedGraph()),
+			start:    3,
+			vertices: []int{3},
+			edges:    0,
+			distance: []int{-1, -1, -1, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the traversals are part of graph.Graph
+			var g graph.Graph = tt.g
+			vertices := make([]int, 0)
+			edges := 0
+			g.BFS(tt.start, func(id int, name string, edges []*graph.Edge) {
+				vertices = append(vertices, id)
+			}, func(edge *graph.Edge) bool {
+				edges++
+				return false
+			})
+			if fmt.Sprint(vertices) != fmt.Sprint(tt.vertices) || edges != tt.edges {
+				t.Errorf("BFS() visited %v and %v edges, want %v and %v edges", vertices, edges, tt.vertices, tt.edges)
+			}
+			reached := 0
+			g.DFS(tt.start, func(id int, name string, edges []*graph.Edge) {
+				reached++
+			}, nil, nil)
+			if reached != len(tt.vertices) {
+				t.Errorf("DFS() reached %v vertices, want %v", reached, len(tt.vertices))
+			}
+			if got := tt.g.BFSTree(tt.start).Distance; fmt.Sprint(got) != fmt.Sprint(tt.distance) {
+				t.Errorf("BFSTree() distance = %v, want %v", got, tt.distance)
+			}
+		})
+	}
+}
+
+func TestDirectedGraph_DFS(t *testing.T) {
+	g := graph.NewDirectedGraph()
+	for i := 0; i < 4; i++ {
+		g.AddVertex("v for vertex")
+	}
+	g.AddEdge(0, 2, 0)
+	g.AddEdge(0, 1, 0)
+	g.AddEdge(1, 2, 0)
+	g.AddEdge(2, 0, 0)
+	g.AddEdge(3, 1, 0)
+	edges := make([]string, 0)
+	g.DFSAll(nil, nil, func(edge *graph.Edge, edgeType graph.EdgeType) bool {
+		edges = append(edges, fmt.Sprintf("%v-%v %v", edge.From, edge.To, edgeType))
+		return false
+	})
+	want := []string{"0-1 tree", "1-2 tree", "2-0 back", "0-2 forward", "3-1 cross"}
+	if fmt.Sprint(edges) != fmt.Sprint(want) {
+		t.Errorf("DFSAll() edges = %v, want %v", edges, want)
+	}
+}
+
+func TestDirectedGraph_HasCycle(t *testing.T) {
+	type edge struct {
+		a, b int
+	}
+	tests := []struct {
+		name  string
+		edges []edge
+		want  bool
+	}{
+		{
+			name:  "diamond",
+			edges: []edge{{0, 1}, {0, 2}, {1, 3}, {2, 3}},
+			want:  false,
+		},
+		{
+			name:  "two vertex cycle",
+			edges: []edge{{0, 1}, {1, 0}},
+			want:  true,
+		},
+		{
+			name:  "cycle not reachable from 0",
+			edges: []edge{{0, 1}, {2, 3}, {3, 2}},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewDirectedGraph()
+			for i := 0; i < 4; i++ {
+				g.AddVertex("v for vertex")
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e.a, e.b, 0)
+			}
+			if got := g.HasCycle(); got != tt.want {
+				t.Errorf("HasCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package graph
+
+import "errors"
+
+var (
+	// ErrTooManyVertices is returned when an exponential algorithm is called on a graph that is too large for it
+	ErrTooManyVertices = errors.New("too many vertices")
+	// ErrNoPath is returned when the requested path or tour does not exist in the graph
+	ErrNoPath = errors.New("no path")
+	// ErrVertexNotFound is returned when a vertex id does not belong to a vertex of the graph
+	ErrVertexNotFound = errors.New("vertex not found")
+	// ErrNegativeWeight is returned when an algorithm that requires non-negative edge weights finds a negative weight
+	ErrNegativeWeight = errors.New("negative edge weight")
+	// ErrNegativeCycle is returned when shortest paths do not exist because a cycle whose total weight is negative can be reached
+	ErrNegativeCycle = errors.New("negative cycle")
+	// ErrDisconnected is returned when an algorithm requires a connected graph
+	ErrDisconnected = errors.New("graph is not connected")
+)
@@ -0,0 +1,142 @@
+package graph
+
+import "sync"
+
+// Graph is implemented by UndirectedGraph and DirectedGraph.
+// Searches follow the 'outgoing' edges of vertices, i.e. VertexEdges, and every edge of an undirected graph goes both ways.
+// Besides storage only the BFS and DFS traversals are part of the interface, the other algorithms are methods of the graph types.
+type Graph interface {
+	AddVertex(name string) int
+	RemoveVertex(a int)
+	AddEdge(a, b, w int)
+	RemoveEdge(a, b int)
+	Vertices() []*Vertex
+	VertexEdges(a int) []*Edge
+	Edges() []*Edge
+	BFS(start int, processVertex func(vertexId int, vertexName string, vertexEdges []*Edge), processEdge func(edge *Edge) (terminate bool))
+	DFS(start int,
+		preVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+		postVertex func(vertexId int, vertexName string, vertexEdges []*Edge),
+		processEdge func(edge *Edge, edgeType EdgeType) (terminate bool)) *DFSTree
+}
+
+var (
+	_ Graph = (*UndirectedGraph)(nil)
+	_ Graph = (*DirectedGraph)(nil)
+)
+
+// adjacencyList is the core that is shared by UndirectedGraph and DirectedGraph.
+// Vertex ids are indexes of vertices, removed vertices are left as nil so ids never change.
+type adjacencyList struct {
+	mtx      *sync.RWMutex
+	vertices []*vertex
+	directed bool
+}
+
+func newAdjacencyList(directed bool) adjacencyList {
+	return adjacencyList{
+		mtx:      new(sync.RWMutex),
+		vertices: make([]*vertex, 0),
+		directed: directed,
+	}
+}
+
+// AddVertex adds a named vertex to the graph. Graph's counter is used as an id for the vertex.
+// returns the id of the added vertex.
+func (g *adjacencyList) AddVertex(name string) int {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	v := &vertex{
+		id:    len(g.vertices),
+		name:  name,
+		edges: nil,
+	}
+	g.vertices = append(g.vertices, v)
+	return v.id
+}
+
+// hasVertex returns true if a is the id of a vertex in the graph
+func (g *adjacencyList) hasVertex(a int) bool {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	return g.validVertexSafe(a)
+}
+
+// Vertices returns all the vertex id and names in the graph, returns nil if the graph has no vertices
+func (g *adjacencyList) Vertices() []*Vertex {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	r := make([]*Vertex, 0)
+	for _, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		r = append(r, &Vertex{ID: v.id, Name: v.name})
+	}
+	if len(r) == 0 {
+		r = nil
+	}
+	return r
+}
+
+// VertexEdges lists all the edges that are connected to a given vertex, i.e. its outgoing edges in a directed graph
+func (g *adjacencyList) VertexEdges(a int) []*Edge {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+
+	if a >= len(g.vertices) || a < 0 {
+		return nil
+	}
+
+	if g.vertices[a] == nil {
+		return nil
+	}
+	return g.vertexEdgesSafe(a)
+}
+
+func (g *adjacencyList) vertexEdgesSafe(a int) []*Edge {
+	r := make([]*Edge, 0)
+	e := g.vertices[a].edges
+
+	for e != nil {
+		r = append(r, &Edge{From: g.vertices[a].id, To: e.to, Weight: e.weight})
+		e = e.next
+	}
+
+	if len(r) == 0 {
+		r = nil
+	}
+	return r
+}
+
+// validVertexSafe returns true if a is the id of a vertex in the graph.
+// it's assumed that the lock is held
+func (g *adjacencyList) validVertexSafe(a int) bool {
+	return a >= 0 && a < len(g.vertices) && g.vertices[a] != nil
+}
+
+// setEdge updates the weight of the edge to b in the list, or adds it to the front of the list if it does not exist
+func setEdge(list **edge, b, w int) {
+	for e := *list; e != nil; e = e.next {
+		if e.to == b {
+			e.weight = w
+			return
+		}
+	}
+	*list = &edge{
+		to:     b,
+		weight: w,
+		next:   *list,
+	}
+}
+
+// deleteEdge removes the edge to b from the list, returns false if there is no such edge
+func deleteEdge(list **edge, b int) bool {
+	for p := list; *p != nil; p = &(*p).next {
+		if (*p).to == b {
+			*p = (*p).next
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"sort"
+
+	"algorithms/datastructures/minpriorityqueue"
+	"algorithms/datastructures/unionfind"
+)
+
+// Minimum spanning forest: the lightest set of edges that connects every pair of vertices that the graph connects.
+// It is a minimum spanning tree of every connected component, so a connected graph gets a single tree.
+// Edges are listed with From < To as in Edges.
+
+// Kruskal finds a minimum spanning forest by taking edges in increasing order of weight,
+// skipping the edges whose ends are already connected. Returns the edges of the forest and their total weight.
+func (g *UndirectedGraph) Kruskal() ([]*Edge, int) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	return g.kruskalSafe()
+}
+
+// kruskalSafe is Kruskal for callers that already hold the lock.
+func (g *UndirectedGraph) kruskalSafe() ([]*Edge, int) {
+	edges := g.edgesSafe()
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].Weight < edges[j].Weight })
+	u := unionfind.NewUnionFind(len(g.vertices))
+	forest := make([]*Edge, 0)
+	total := 0
+	for _, e := range edges {
+		if u.Union(e.From, e.To) {
+			forest = append(forest, e)
+			total += e.Weight
+		}
+	}
+	return forest, total
+}
+
+// Prim finds a minimum spanning forest by growing a tree from the vertex with the smallest id that is not in the forest yet,
+// always adding the lightest edge that leaves the tree. Vertices outside the tree are kept in a min heap keyed by the weight
+// of their lightest edge to the tree. Returns the edges of the forest and their total weight.
+func (g *UndirectedGraph) Prim() ([]*Edge, int) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	n := len(g.vertices)
+	inTree := make([]bool, n)
+	// parent[v] is the other end of the lightest edge from v to the tree
+	parent := make([]int, n)
+	q := minpriorityqueue.NewMinPriorityQueue(n)
+	forest := make([]*Edge, 0)
+	total := 0
+	for _, root := range g.vertices {
+		if root == nil || inTree[root.id] {
+			continue
+		}
+		parent[root.id] = -1
+		q.Insert(root.id, 0)
+		for q.Len() > 0 {
+			v, w := q.ExtractMinimum()
+			inTree[v] = true
+			if parent[v] != -1 {
+				forest = append(forest, &Edge{From: minInt(v, parent[v]), To: maxInt(v, parent[v]), Weight: w})
+				total += w
+			}
+			for e := g.vertices[v].edges; e != nil; e = e.next {
+				switch {
+				case inTree[e.to]:
+				case q.Contains(e.to):
+					if e.weight < q.Key(e.to) {
+						q.DecreaseKey(e.to, e.weight)
+						parent[e.to] = v
+					}
+				default:
+					q.Insert(e.to, e.weight)
+					parent[e.to] = v
+				}
+			}
+		}
+	}
+	return forest, total
+}
+
+// MinimumSpanningTree finds a minimum spanning tree with Kruskal.
+// Returns the edges of the tree and their total weight, or ErrDisconnected if the graph is not connected.
+func (g *UndirectedGraph) MinimumSpanningTree() ([]*Edge, int, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	forest, total := g.kruskalSafe()
+	vertices := 0
+	for _, v := range g.vertices {
+		if v != nil {
+			vertices++
+		}
+	}
+	if len(forest) < vertices-1 {
+		return nil, 0, ErrDisconnected
+	}
+	return forest, total, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,153 @@
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"algorithms/datastructures/unionfind"
+	"algorithms/graph"
+)
+
+var spanningForestSolvers = map[string]func(g *graph.UndirectedGraph) ([]*graph.Edge, int){
+	"Kruskal()": (*graph.UndirectedGraph).Kruskal,
+	"Prim()":    (*graph.UndirectedGraph).Prim,
+}
+
+// checkSpanningForest checks that forest is made of edges of the graph, has no cycles, weighs total
+// and connects every pair of vertices that the graph connects
+func checkSpanningForest(t *testing.T, name string, g *graph.UndirectedGraph, forest []*graph.Edge, total int) {
+	n := 0
+	for _, v := range g.Vertices() {
+		if v.ID >= n {
+			n = v.ID + 1
+		}
+	}
+	u := unionfind.NewUnionFind(n)
+	sum := 0
+	for _, e := range forest {
+		if e.From >= e.To {
+			t.Fatalf("%v edge %v - %v is not listed with From < To", name, e.From, e.To)
+		}
+		if w, ok := pathWeight(g, []int{e.From, e.To}); !ok || w != e.Weight {
+			t.Fatalf("%v edge %v - %v with weight %v is not an edge of the graph", name, e.From, e.To, e.Weight)
+		}
+		if !u.Union(e.From, e.To) {
+			t.Fatalf("%v edge %v - %v closes a cycle", name, e.From, e.To)
+		}
+		sum += e.Weight
+	}
+	if sum != total {
+		t.Fatalf("%v total = %v, edges weigh %v", name, total, sum)
+	}
+	for _, e := range g.Edges() {
+		if !u.Connected(e.From, e.To) {
+			t.Fatalf("%v does not connect %v and %v", name, e.From, e.To)
+		}
+	}
+}
+
+func TestUndirectedGraph_SpanningForest(t *testing.T) {
+	tests := []struct {
+		name        string
+		vertexCount int
+		edges       []weightedEdge
+		removed     []int
+		want        int
+		trees       int
+	}{
+		{
+			name:        "empty",
+			vertexCount: 0,
+			want:        0,
+			trees:       0,
+		},
+		{
+			name:        "single vertex",
+			vertexCount: 1,
+			want:        0,
+			trees:       1,
+		},
+		{
+			// the example in CLRS with vertices a to i renamed to 0 to 8
+			name:        "connected",
+			vertexCount: 9,
+			edges: []weightedEdge{
+				{0, 1, 4}, {0, 7, 8}, {1, 2, 8}, {1, 7, 11}, {2, 3, 7}, {2, 5, 4}, {2, 8, 2},
+				{3, 4, 9}, {3, 5, 14}, {4, 5, 10}, {5, 6, 2}, {6, 7, 1}, {6, 8, 6}, {7, 8, 7},
+			},
+			want:  37,
+			trees: 1,
+		},
+		{
+			name:        "negative weights",
+			vertexCount: 4,
+			edges:       []weightedEdge{{0, 1, -2}, {1, 2, 3}, {2, 3, -1}, {3, 0, 0}, {0, 2, 5}},
+			want:        -3,
+			trees:       1,
+		},
+		{
+			name:        "disconnected",
+			vertexCount: 7,
+			edges:       []weightedEdge{{0, 1, 5}, {1, 2, 1}, {0, 2, 2}, {3, 4, 7}, {4, 5, 3}, {3, 5, 3}},
+			want:        9,
+			trees:       3,
+		},
+		{
+			name:        "removed vertex",
+			vertexCount: 4,
+			edges:       []weightedEdge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {0, 3, 10}},
+			removed:     []int{1},
+			want:        11,
+			trees:       1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newWeightedGraph(graph.NewUndirectedGraph(), tt.vertexCount, tt.edges).(*graph.UndirectedGraph)
+			for _, v := range tt.removed {
+				g.RemoveVertex(v)
+			}
+			for name, solve := range spanningForestSolvers {
+				forest, total := solve(g)
+				if total != tt.want || len(forest) != len(g.Vertices())-tt.trees {
+					t.Errorf("%v = %v edges weighing %v, want %v", name, len(forest), total, tt.want)
+				}
+				checkSpanningForest(t, name, g, forest, total)
+			}
+			tree, total, err := g.MinimumSpanningTree()
+			if tt.trees > 1 {
+				if err != graph.ErrDisconnected {
+					t.Errorf("MinimumSpanningTree() error = %v, want %v", err, graph.ErrDisconnected)
+				}
+				return
+			}
+			if err != nil || total != tt.want {
+				t.Errorf("MinimumSpanningTree() = %v, %v, %v, want %v", tree, total, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUndirectedGraph_SpanningForestRandom(t *testing.T) {
+	for c := 0; c < 200; c++ {
+		n := 1 + rand.Intn(30)
+		g := graph.NewUndirectedGraph()
+		for i := 0; i < n; i++ {
+			g.AddVertex(fmt.Sprintf("vertex_%v", i))
+		}
+		for i := rand.Intn(3 * n); i > 0; i-- {
+			g.AddEdge(rand.Intn(n), rand.Intn(n), rand.Intn(20)-5)
+		}
+		if rand.Intn(3) == 0 {
+			g.RemoveVertex(rand.Intn(n))
+		}
+		kruskal, kruskalTotal := g.Kruskal()
+		prim, primTotal := g.Prim()
+		if kruskalTotal != primTotal || len(kruskal) != len(prim) {
+			t.Fatalf("Kruskal() = %v edges weighing %v, Prim() = %v edges weighing %v", len(kruskal), kruskalTotal, len(prim), primTotal)
+		}
+		checkSpanningForest(t, "Kruskal()", g, kruskal, kruskalTotal)
+		checkSpanningForest(t, "Prim()", g, prim, primTotal)
+	}
+}
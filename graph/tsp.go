@@ -0,0 +1,80 @@
+package graph
+
+import "algorithms/dynamicprogramming"
+
+// MaxBitmaskVertices is the maximum number of vertices for bitmask dynamic programming based algorithms, i.e. TSP and HamiltonianPath
+const MaxBitmaskVertices = 20
+
+// TSP finds the cheapest tour that visits every vertex of the graph exactly once and returns to the starting vertex
+// by using Held-Karp algorithm on the edge weights.
+// Returns the cost and the tour as vertex ids, where the tour starts and ends at the vertex with the smallest id.
+// Returns ErrTooManyVertices if the graph has more than MaxBitmaskVertices vertices and ErrNoPath if there is no tour.
+func (g *UndirectedGraph) TSP() (int, []int, error) {
+	ids, weight, err := g.bitmaskWeights()
+	if err != nil {
+		return 0, nil, err
+	}
+	cost, tour, ok := dynamicprogramming.SolveTSPHeldKarp(len(ids), weight)
+	if !ok {
+		return 0, nil, ErrNoPath
+	}
+	for i := range tour {
+		tour[i] = ids[tour[i]]
+	}
+	return cost, tour, nil
+}
+
+// HamiltonianPath finds the cheapest path that visits every vertex of the graph exactly once.
+// Returns the cost and the path as vertex ids.
+// Returns ErrTooManyVertices if the graph has more than MaxBitmaskVertices vertices and ErrNoPath if there is no such path.
+func (g *UndirectedGraph) HamiltonianPath() (int, []int, error) {
+	ids, weight, err := g.bitmaskWeights()
+	if err != nil {
+		return 0, nil, err
+	}
+	cost, path, ok := dynamicprogramming.SolveHamiltonianPathBitmask(len(ids), weight)
+	if !ok {
+		return 0, nil, ErrNoPath
+	}
+	for i := range path {
+		path[i] = ids[path[i]]
+	}
+	return cost, path, nil
+}
+
+// bitmaskWeights maps the vertices of the graph to 0, ..., n-1 since removed vertices leave gaps in ids.
+// Returns the vertex ids in the order of their new indexes and a weight function on the new indexes.
+func (g *UndirectedGraph) bitmaskWeights() ([]int, dynamicprogramming.WeightFunc, error) {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+	ids := make([]int, 0)
+	index := make([]int, len(g.vertices))
+	for _, v := range g.vertices {
+		if v == nil {
+			continue
+		}
+		index[v.id] = len(ids)
+		ids = append(ids, v.id)
+	}
+	if len(ids) > MaxBitmaskVertices {
+		return nil, nil, ErrTooManyVertices
+	}
+	n := len(ids)
+	w := make([][]int, n)
+	connected := make([][]bool, n)
+	for i := range w {
+		w[i] = make([]int, n)
+		connected[i] = make([]bool, n)
+	}
+	var e *edge
+	for _, id := range ids {
+		for e = g.vertices[id].edges; e != nil; e = e.next {
+			w[index[id]][index[e.to]] = e.weight
+			connected[index[id]][index[e.to]] = true
+		}
+	}
+	weight := func(i, j int) (int, bool) {
+		return w[i][j], connected[i][j]
+	}
+	return ids, weight, nil
+}
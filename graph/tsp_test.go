@@ -0,0 +1,189 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// permutations calls f with every permutation of p[k:]
+func permutations(p []int, k int, f func(p []int)) {
+	if k == len(p) {
+		f(p)
+		return
+	}
+	for i := k; i < len(p); i++ {
+		p[k], p[i] = p[i], p[k]
+		permutations(p, k+1, f)
+		p[k], p[i] = p[i], p[k]
+	}
+}
+
+// bruteForceTSP tries every permutation of vertices. Returns -1 if there is no tour.
+// Paths are tried if path is true, otherwise tours, i.e. paths that return to the first vertex.
+func bruteForceTSP(g *UndirectedGraph, path bool) int {
+	ids := make([]int, 0)
+	for _, v := range g.Vertices() {
+		ids = append(ids, v.ID)
+	}
+	w := make(map[[2]int]int)
+	for _, e := range g.Edges() {
+		w[[2]int{e.From, e.To}] = e.Weight
+		w[[2]int{e.To, e.From}] = e.Weight
+	}
+	best := -1
+	start := 1
+	if path {
+		start = 0
+	}
+	permutations(ids, start, func(p []int) {
+		cost := 0
+		for i := 1; i <= len(p); i++ {
+			if i == len(p) && (path || len(p) == 1) {
+				break
+			}
+			c, ok := w[[2]int{p[i-1], p[i%len(p)]}]
+			if !ok {
+				return
+			}
+			cost += c
+		}
+		if best == -1 || cost < best {
+			best = cost
+		}
+	})
+	return best
+}
+
+// checkTour checks that tour visits every vertex once, uses existing edges and costs cost
+func checkTour(t *testing.T, g *UndirectedGraph, tour []int, cost int, closed bool) {
+	visited := make(map[int]bool)
+	vertices := tour
+	if closed {
+		if tour[0] != tour[len(tour)-1] {
+			t.Fatalf("tour %v does not return to the start", tour)
+		}
+		vertices = tour[:len(tour)-1]
+	}
+	for _, v := range vertices {
+		if visited[v] {
+			t.Fatalf("tour %v visits %v twice", tour, v)
+		}
+		visited[v] = true
+	}
+	if len(visited) != len(g.Vertices()) {
+		t.Fatalf("tour %v does not visit every vertex", tour)
+	}
+	total := 0
+	if len(vertices) == 1 {
+		tour = vertices // a single vertex tour stays where it is
+	}
+	for i := 1; i < len(tour); i++ {
+		found := false
+		for _, e := range g.VertexEdges(tour[i-1]) {
+			if e.To == tour[i] {
+				total += e.Weight
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("tour %v uses a missing edge %v - %v", tour, tour[i-1], tour[i])
+		}
+	}
+	if total != cost {
+		t.Fatalf("tour %v costs %v, want %v", tour, total, cost)
+	}
+}
+
+func TestUndirectedGraph_TSP(t *testing.T) {
+	// classic 4 city example
+	g := NewUndirectedGraph()
+	for i := 0; i < 4; i++ {
+		g.AddVertex("city")
+	}
+	g.AddEdge(0, 1, 10)
+	g.AddEdge(0, 2, 15)
+	g.AddEdge(0, 3, 20)
+	g.AddEdge(1, 2, 35)
+	g.AddEdge(1, 3, 25)
+	g.AddEdge(2, 3, 30)
+	cost, tour, err := g.TSP()
+	if err != nil || cost != 80 {
+		t.Fatalf("TSP() = %v, %v, %v want 80", cost, tour, err)
+	}
+	checkTour(t, g, tour, cost, true)
+
+	// a path graph has no tour but has a hamiltonian path
+	p := NewUndirectedGraph()
+	for i := 0; i < 5; i++ {
+		p.AddVertex("v")
+		if i > 0 {
+			p.AddEdge(i-1, i, i)
+		}
+	}
+	if _, _, err := p.TSP(); err != ErrNoPath {
+		t.Fatalf("TSP() error = %v, want ErrNoPath", err)
+	}
+	cost, path, err := p.HamiltonianPath()
+	if err != nil || cost != 10 {
+		t.Fatalf("HamiltonianPath() = %v, %v, %v want 10", cost, path, err)
+	}
+	checkTour(t, p, path, cost, false)
+
+	big := NewUndirectedGraph()
+	for i := 0; i <= MaxBitmaskVertices; i++ {
+		big.AddVertex("v")
+	}
+	if _, _, err := big.TSP(); err != ErrTooManyVertices {
+		t.Fatalf("TSP() error = %v, want ErrTooManyVertices", err)
+	}
+	big.RemoveVertex(0)
+	if _, _, err := big.HamiltonianPath(); err != ErrNoPath {
+		t.Fatalf("HamiltonianPath() error = %v, want ErrNoPath", err)
+	}
+}
+
+func TestUndirectedGraph_TSPRandom(t *testing.T) {
+	for c := 0; c < 30; c++ {
+		n := 1 + rand.Intn(7)
+		g := NewUndirectedGraph()
+		for i := 0; i < n+2; i++ {
+			g.AddVertex("v")
+		}
+		for i := 0; i < n+2; i++ {
+			for j := i + 1; j < n+2; j++ {
+				if rand.Intn(4) > 0 {
+					g.AddEdge(i, j, rand.Intn(100))
+				}
+			}
+		}
+		// leave gaps in vertex ids
+		g.RemoveVertex(rand.Intn(n + 2))
+		g.RemoveVertex(rand.Intn(n + 2))
+
+		want := bruteForceTSP(g, false)
+		cost, tour, err := g.TSP()
+		if want == -1 {
+			if err != ErrNoPath {
+				t.Fatalf("TSP() = %v, %v, %v want ErrNoPath", cost, tour, err)
+			}
+		} else {
+			if err != nil || cost != want {
+				t.Fatalf("TSP() = %v, %v, %v want %v", cost, tour, err, want)
+			}
+			checkTour(t, g, tour, cost, true)
+		}
+
+		want = bruteForceTSP(g, true)
+		cost, path, err := g.HamiltonianPath()
+		if want == -1 {
+			if err != ErrNoPath {
+				t.Fatalf("HamiltonianPath() = %v, %v, %v want ErrNoPath", cost, path, err)
+			}
+		} else {
+			if err != nil || cost != want {
+				t.Fatalf("HamiltonianPath() = %v, %v, %v want %v", cost, path, err, want)
+			}
+			checkTour(t, g, path, cost, false)
+		}
+	}
+}
@@ -1,10 +1,13 @@
-package graph
+package graph_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
 	"testing"
+
+	"algorithms/graph"
 )
 
 func TestUndirectedGraph_AddVertex(t *testing.T) {
@@ -38,7 +41,7 @@ func TestUndirectedGraph_AddVertex(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewUndirectedGraph()
+			g := graph.NewUndirectedGraph()
 			for i := 0; i < tt.count; i++ {
 				name := fmt.Sprintf("V_%v", strconv.Itoa(i+1))
 				g.AddVertex(name)
@@ -87,7 +90,7 @@ func TestUndirectedGraph_RemoveVertex(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewUndirectedGraph()
+			g := graph.NewUndirectedGraph()
 			for i := 0; i < tt.vertexcount; i++ {
 				name := fmt.Sprintf("V_%v", strconv.Itoa(i+1))
 				g.AddVertex(name)
@@ -202,7 +205,7 @@ func TestUndirectedGraph_AddEdge(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewUndirectedGraph()
+			g := graph.NewUndirectedGraph()
 			for i := 0; i < tt.vertices; i++ {
 				g.AddVertex(fmt.Sprintf("vertex_%v", strconv.Itoa(i)))
 			}
@@ -215,9 +218,9 @@ func TestUndirectedGraph_AddEdge(t *testing.T) {
 			}
 			for _, ee := range edges {
 				for _, e := range tt.edges {
-					if (ee.from == e.v1 && ee.to == e.v2) || (ee.to == e.v1 && ee.from == e.v2) {
-						if ee.weight != e.w {
-							t.Fatalf("invalid weight, want: %v, actual: %v", e.w, ee.weight)
+					if (ee.From == e.v1 && ee.To == e.v2) || (ee.To == e.v1 && ee.From == e.v2) {
+						if ee.Weight != e.w {
+							t.Fatalf("invalid weight, want: %v, actual: %v", e.w, ee.Weight)
 						}
 					}
 				}
@@ -323,7 +326,7 @@ func TestUndirectedGraph_RemoveEdge(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewUndirectedGraph()
+			g := graph.NewUndirectedGraph()
 			for i := 0; i < tt.vertices; i++ {
 				g.AddVertex(fmt.Sprintf("vertex_%v", strconv.Itoa(i)))
 			}
@@ -339,9 +342,9 @@ func TestUndirectedGraph_RemoveEdge(t *testing.T) {
 			}
 			for _, ee := range edges {
 				for _, e := range tt.addEdges {
-					if (ee.from == e.v1 && ee.to == e.v2) || (ee.to == e.v1 && ee.from == e.v2) {
-						if ee.weight != e.w {
-							t.Fatalf("invalid weight, want: %v, actual: %v", e.w, ee.weight)
+					if (ee.From == e.v1 && ee.To == e.v2) || (ee.To == e.v1 && ee.From == e.v2) {
+						if ee.Weight != e.w {
+							t.Fatalf("invalid weight, want: %v, actual: %v", e.w, ee.Weight)
 						}
 					}
 				}
@@ -349,3 +352,31 @@ func TestUndirectedGraph_RemoveEdge(t *testing.T) {
 		})
 	}
 }
+
+func TestUndirectedGraph_JSON(t *testing.T) {
+	g := graph.NewUndirectedGraph()
+	a := g.AddVertex("a")
+	b := g.AddVertex("b")
+	g.AddEdge(a, b, 7)
+	vertices, err := json.Marshal(g.Vertices())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"id":0,"name":"a"},{"id":1,"name":"b"}]`; string(vertices) != want {
+		t.Errorf("vertices json = %s, want %s", vertices, want)
+	}
+	edges, err := json.Marshal(g.Edges())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"from":0,"to":1,"weight":7}]`; string(edges) != want {
+		t.Errorf("edges json = %s, want %s", edges, want)
+	}
+	var decoded []*graph.Edge
+	if err := json.Unmarshal(edges, &decoded); err != nil || len(decoded) != 1 || *decoded[0] != (graph.Edge{From: a, To: b, Weight: 7}) {
+		t.Errorf("decoded edges = %v, %v", decoded, err)
+	}
+	if e := g.VertexEdges(b); len(e) != 1 || e[0].From != b || e[0].To != a || e[0].Weight != 7 {
+		t.Errorf("VertexEdges() = %v, want an edge from %v to %v", e, b, a)
+	}
+}